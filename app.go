@@ -3,7 +3,6 @@ package vk
 import (
 	"context"
 	"fmt"
-	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -14,25 +13,160 @@ import (
 )
 
 type AccessToken struct {
-	Token   string
+	Token string
+
+	// Expires is the token expiration time. It is zero for tokens which do
+	// not expire (e.g. received with ScopeOffline).
 	Expires time.Time
 	UserID  int
+
+	// Scope is the set of permissions requested for the token.
+	Scope Scope
+
+	// Kind is the kind of token owner.
+	Kind TokenKind
+
+	// GroupID is the id of community for TokenCommunity tokens.
+	GroupID int
+
+	// GroupScope is the set of permissions requested for TokenCommunity
+	// token.
+	GroupScope GroupScope
+}
+
+// OwnerID returns id of the token owner as it used in owner_id parameters:
+// that is, negative community id for TokenCommunity tokens and user id
+// otherwise.
+func (t *AccessToken) OwnerID() int {
+	if t.Kind == TokenCommunity {
+		return -t.GroupID
+	}
+	return t.UserID
+}
+
+// TokenKind describes whom access token is issued for.
+type TokenKind int
+
+const (
+	// TokenUser is a token issued for user with authorization code or
+	// implicit flow.
+	TokenUser TokenKind = iota
+
+	// TokenCommunity is a token issued for community.
+	TokenCommunity
+
+	// TokenService is a token issued for application itself with client
+	// credentials flow. It could be used only for calls of methods which do
+	// not require user authorization.
+	TokenService
+)
+
+func (k TokenKind) String() string {
+	switch k {
+	case TokenUser:
+		return "user"
+	case TokenCommunity:
+		return "community"
+	case TokenService:
+		return "service"
+	default:
+		return "TokenKind(" + strconv.Itoa(int(k)) + ")"
+	}
+}
+
+// Valid reports whether token is non-empty and not expired.
+func (t *AccessToken) Valid() bool {
+	if t == nil || t.Token == "" {
+		return false
+	}
+	return t.Expires.IsZero() || time.Now().Before(t.Expires)
+}
+
+// TokenInfo contains information about token returned by App.Introspect().
+type TokenInfo struct {
+	UserID  int
+	Date    time.Time
+	Expires time.Time
+}
+
+// Introspect checks user token with secure.checkToken method. Service token
+// of the app is required to make such call.
+//
+// If token is invalid, it returns error with ErrAccessDenied code.
+func (a *App) Introspect(ctx context.Context, service *AccessToken, token string) (*TokenInfo, error) {
+	bts, err := clientOrDefault(a.Client).Request(ctx, "secure.checkToken",
+		WithAccessToken(service),
+		WithParam("client_secret", a.ClientSecret),
+		WithParam("token", token),
+	)
+	if err == nil {
+		bts, err = StripResponse(bts)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var info rawTokenInfo
+	if err := info.UnmarshalJSON(bts); err != nil {
+		return nil, err
+	}
+	ret := &TokenInfo{
+		UserID: info.UserID,
+	}
+	if info.Date != 0 {
+		ret.Date = time.Unix(info.Date, 0)
+	}
+	if info.Expire != 0 {
+		ret.Expires = time.Unix(info.Expire, 0)
+	}
+	return ret, nil
 }
 
 type App struct {
 	ClientID     string
 	ClientSecret string
 	Scope        Scope
+
+	// Client is used to make authorization requests. If nil, DefaultClient
+	// is used.
+	Client *Client
 }
 
 func (a *App) Authorize(ctx context.Context, redirectPath, code string) (*AccessToken, error) {
-	access, err := http.NewRequest(
-		"GET", a.AccessTokenPath(redirectPath, code), nil,
-	)
+	token, err := a.accessToken(ctx, a.AccessTokenPath(redirectPath, code))
+	if err != nil {
+		return nil, err
+	}
+	token.Scope = a.Scope
+
+	return token, nil
+}
+
+// ServiceToken returns service token of the app received with client
+// credentials flow. Service token does not expire and has no user.
+func (a *App) ServiceToken(ctx context.Context) (*AccessToken, error) {
+	token, err := a.accessToken(ctx, a.ServiceTokenPath())
+	if err != nil {
+		return nil, err
+	}
+	token.Kind = TokenService
+
+	return token, nil
+}
+
+func (a *App) accessToken(ctx context.Context, u string) (*AccessToken, error) {
+	bts, err := a.oauth(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	return parseAccessTokenResponse(bts)
+}
+
+func (a *App) oauth(ctx context.Context, u string) ([]byte, error) {
+	access, err := http.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := http.DefaultClient.Do(access.WithContext(ctx))
+	resp, err := clientOrDefault(a.Client).httpClient().Do(access.WithContext(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -41,7 +175,7 @@ func (a *App) Authorize(ctx context.Context, redirectPath, code string) (*Access
 	if err := httputil.CheckResponseStatus(resp); err != nil {
 		return nil, err
 	}
-	return parseAccessTokenResponse(resp.Body)
+	return ioutil.ReadAll(resp.Body)
 }
 
 func (a *App) AuthPathToken(redirect string, options ...QueryOption) string {
@@ -53,12 +187,13 @@ func (a *App) AuthPathCode(redirect string, options ...QueryOption) string {
 }
 
 func (a *App) authorizePath(redirect, authType string, options ...QueryOption) string {
-	auth, err := url.Parse("https://oauth.vk.com/authorize")
+	client := clientOrDefault(a.Client)
+	auth, err := url.Parse(client.oauthURL() + "/authorize")
 	if err != nil {
-		panic("constant url is invalid: " + err.Error())
+		panic("oauth url is invalid: " + err.Error())
 	}
 	query := url.Values{
-		"v":             []string{version},
+		"v":             []string{client.version()},
 		"client_id":     []string{a.ClientID},
 		"redirect_uri":  []string{redirect},
 		"scope":         []string{a.Scope.String()},
@@ -72,9 +207,9 @@ func (a *App) authorizePath(redirect, authType string, options ...QueryOption) s
 }
 
 func (a *App) AccessTokenPath(redirect, code string) string {
-	access, err := url.Parse("https://oauth.vk.com/access_token")
+	access, err := url.Parse(clientOrDefault(a.Client).oauthURL() + "/access_token")
 	if err != nil {
-		panic("constant url is invalid: " + err.Error())
+		panic("oauth url is invalid: " + err.Error())
 	}
 	query := url.Values{
 		"client_id":     []string{a.ClientID},
@@ -86,11 +221,23 @@ func (a *App) AccessTokenPath(redirect, code string) string {
 	return access.String()
 }
 
-func parseAccessTokenResponse(resp io.Reader) (*AccessToken, error) {
-	bts, err := ioutil.ReadAll(resp)
+func (a *App) ServiceTokenPath() string {
+	client := clientOrDefault(a.Client)
+	access, err := url.Parse(client.oauthURL() + "/access_token")
 	if err != nil {
-		return nil, err
+		panic("oauth url is invalid: " + err.Error())
+	}
+	query := url.Values{
+		"v":             []string{client.version()},
+		"client_id":     []string{a.ClientID},
+		"client_secret": []string{a.ClientSecret},
+		"grant_type":    []string{"client_credentials"},
 	}
+	access.RawQuery = query.Encode()
+	return access.String()
+}
+
+func parseAccessTokenResponse(bts []byte) (*AccessToken, error) {
 	var acc rawAccess
 	if err := acc.UnmarshalJSON(bts); err != nil {
 		return nil, err
@@ -114,7 +261,12 @@ func RedirectQueryError(query url.Values) error {
 	)
 }
 
+// expiresDate returns expiration time of token received with expires_in
+// parameter. Zero expires_in means that token does not expire.
 func expiresDate(sec int) time.Time {
+	if sec == 0 {
+		return time.Time{}
+	}
 	return time.Now().Add(time.Second * time.Duration(sec))
 }
 
@@ -0,0 +1,202 @@
+package vk
+
+//go:generate easyjson -all
+
+type AttachmentType string
+
+const (
+	AttachmentPhoto        AttachmentType = "photo"
+	AttachmentVideo        AttachmentType = "video"
+	AttachmentAudio        AttachmentType = "audio"
+	AttachmentDoc          AttachmentType = "doc"
+	AttachmentLink         AttachmentType = "link"
+	AttachmentSticker      AttachmentType = "sticker"
+	AttachmentWall         AttachmentType = "wall"
+	AttachmentPoll         AttachmentType = "poll"
+	AttachmentGift         AttachmentType = "gift"
+	AttachmentGraffiti     AttachmentType = "graffiti"
+	AttachmentAudioMessage AttachmentType = "audio_message"
+	AttachmentMarket       AttachmentType = "market"
+)
+
+// Attachment is an object attached to a message or a post. Only the field
+// named by Type is set.
+type Attachment struct {
+	Type AttachmentType `json:"type"`
+
+	Photo        *Photo        `json:"photo,omitempty"`
+	Video        *Video        `json:"video,omitempty"`
+	Audio        *Audio        `json:"audio,omitempty"`
+	Doc          *Doc          `json:"doc,omitempty"`
+	Link         *Link         `json:"link,omitempty"`
+	Sticker      *Sticker      `json:"sticker,omitempty"`
+	Wall         *Post         `json:"wall,omitempty"`
+	Poll         *Poll         `json:"poll,omitempty"`
+	Gift         *Gift         `json:"gift,omitempty"`
+	Graffiti     *Graffiti     `json:"graffiti,omitempty"`
+	AudioMessage *AudioMessage `json:"audio_message,omitempty"`
+	Market       *MarketItem   `json:"market,omitempty"`
+
+	// Extra holds fields unknown to Attachment.
+	Extra Extra `json:"-"`
+}
+
+// Attachement is an old misspelled name of Attachment.
+//
+// Deprecated: use Attachment instead.
+type Attachement = Attachment
+
+// PostAttachement is an old name of Attachment used by Post.
+//
+// Deprecated: use Attachment instead.
+type PostAttachement = Attachment
+
+// Object returns attached object named by Type. It returns nil for unknown
+// types.
+func (a *Attachment) Object() interface{} {
+	switch a.Type {
+	case AttachmentPhoto:
+		return a.Photo
+	case AttachmentVideo:
+		return a.Video
+	case AttachmentAudio:
+		return a.Audio
+	case AttachmentDoc:
+		return a.Doc
+	case AttachmentLink:
+		return a.Link
+	case AttachmentSticker:
+		return a.Sticker
+	case AttachmentWall:
+		return a.Wall
+	case AttachmentPoll:
+		return a.Poll
+	case AttachmentGift:
+		return a.Gift
+	case AttachmentGraffiti:
+		return a.Graffiti
+	case AttachmentAudioMessage:
+		return a.AudioMessage
+	case AttachmentMarket:
+		return a.Market
+	default:
+		return nil
+	}
+}
+
+type Audio struct {
+	ID        int      `json:"id"`
+	OwnerID   int      `json:"owner_id"`
+	Artist    string   `json:"artist"`
+	Title     string   `json:"title"`
+	Duration  int      `json:"duration"`
+	URL       string   `json:"url"`
+	Date      UnixTime `json:"date"`
+	AccessKey string   `json:"access_key"`
+}
+
+type Doc struct {
+	ID        int        `json:"id"`
+	OwnerID   int        `json:"owner_id"`
+	Title     string     `json:"title"`
+	Size      int        `json:"size"`
+	Ext       string     `json:"ext"`
+	URL       string     `json:"url"`
+	Date      UnixTime   `json:"date"`
+	Type      int        `json:"type"`
+	AccessKey string     `json:"access_key"`
+	Preview   DocPreview `json:"preview"`
+}
+
+type DocPreview struct {
+	Photo struct {
+		Sizes []PhotoSize `json:"sizes"`
+	} `json:"photo"`
+}
+
+type Link struct {
+	URL         string `json:"url"`
+	Title       string `json:"title"`
+	Caption     string `json:"caption"`
+	Description string `json:"description"`
+	Photo       *Photo `json:"photo,omitempty"`
+}
+
+type Sticker struct {
+	ProductID int            `json:"product_id"`
+	StickerID int            `json:"sticker_id"`
+	Images    []StickerImage `json:"images"`
+}
+
+type StickerImage struct {
+	URL    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+type Poll struct {
+	ID        int          `json:"id"`
+	OwnerID   int          `json:"owner_id"`
+	Created   UnixTime     `json:"created"`
+	Question  string       `json:"question"`
+	Votes     int          `json:"votes"`
+	Answers   []PollAnswer `json:"answers"`
+	Anonymous bool         `json:"anonymous"`
+	Multiple  bool         `json:"multiple"`
+	EndDate   UnixTime     `json:"end_date"`
+	Closed    bool         `json:"closed"`
+	AuthorID  int          `json:"author_id"`
+}
+
+type PollAnswer struct {
+	ID    int     `json:"id"`
+	Text  string  `json:"text"`
+	Votes int     `json:"votes"`
+	Rate  float64 `json:"rate"`
+}
+
+type Gift struct {
+	ID       int    `json:"id"`
+	Thumb256 string `json:"thumb_256"`
+	Thumb96  string `json:"thumb_96"`
+	Thumb48  string `json:"thumb_48"`
+}
+
+type Graffiti struct {
+	ID        int    `json:"id"`
+	OwnerID   int    `json:"owner_id"`
+	URL       string `json:"url"`
+	Width     int    `json:"width"`
+	Height    int    `json:"height"`
+	AccessKey string `json:"access_key"`
+}
+
+type AudioMessage struct {
+	ID        int    `json:"id"`
+	OwnerID   int    `json:"owner_id"`
+	Duration  int    `json:"duration"`
+	Waveform  []int  `json:"waveform"`
+	LinkOGG   string `json:"link_ogg"`
+	LinkMP3   string `json:"link_mp3"`
+	AccessKey string `json:"access_key"`
+}
+
+type MarketItem struct {
+	ID           int         `json:"id"`
+	OwnerID      int         `json:"owner_id"`
+	Title        string      `json:"title"`
+	Description  string      `json:"description"`
+	Price        MarketPrice `json:"price"`
+	ThumbPhoto   string      `json:"thumb_photo"`
+	Date         UnixTime    `json:"date"`
+	Availability int         `json:"availability"`
+}
+
+type MarketPrice struct {
+	Amount   string `json:"amount"`
+	Currency struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	} `json:"currency"`
+	Text string `json:"text"`
+}
@@ -0,0 +1,102 @@
+package vk
+
+import (
+	"context"
+	"sync"
+)
+
+// CaptchaGate makes concurrent Callers share captcha challenges.
+//
+// When several calls hit captcha at once, only the first one resolves it.
+// Others wait for resolution to complete and then retry their requests with
+// the same captcha sid and answer. The answer is also reused by calls which
+// hit captcha after resolution is complete, but which requests were sent
+// before that. Thus user is asked only once for all of them. If resolution
+// fails, waiters retry without an answer.
+//
+// Zero value is ready to use.
+type CaptchaGate struct {
+	mu   sync.Mutex
+	cur  *captchaResolution
+	last *captchaResolution
+	seq  uint64
+}
+
+type captchaResolution struct {
+	done chan struct{}
+	sid  string
+	text string
+	ok   bool
+}
+
+// version returns number of successful resolutions made so far. Callers
+// must get it before sending a request and pass it to resolve() if request
+// fails with captcha. It is safe to call version on nil gate.
+func (g *CaptchaGate) version() uint64 {
+	if g == nil {
+		return 0
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.seq
+}
+
+// resolve resolves captcha with given function or waits for the resolution
+// made by another goroutine. It returns sid and text which must be sent with
+// the retried request; they are empty if captcha was not resolved. The seen
+// argument is a version of the gate obtained before the failed request was
+// sent. It is safe to call resolve on nil gate.
+func (g *CaptchaGate) resolve(
+	ctx context.Context, seen uint64, sid, img string,
+	resolve func(context.Context, string) (string, error),
+) (
+	answerSID, text string, err error,
+) {
+	if g == nil {
+		text, err = resolve(ctx, img)
+		return sid, text, err
+	}
+	g.mu.Lock()
+	if last := g.last; last != nil && g.seq > seen {
+		// Request was sent before the latest answer became known.
+		g.mu.Unlock()
+		return last.sid, last.text, nil
+	}
+	if cur := g.cur; cur != nil {
+		g.mu.Unlock()
+		select {
+		case <-cur.done:
+			if !cur.ok {
+				return "", "", nil
+			}
+			return cur.sid, cur.text, nil
+		case <-ctx.Done():
+			return "", "", ctx.Err()
+		}
+	}
+	cur := &captchaResolution{
+		done: make(chan struct{}),
+		sid:  sid,
+	}
+	g.cur = cur
+	g.mu.Unlock()
+
+	defer func() {
+		g.mu.Lock()
+		g.cur = nil
+		if cur.ok {
+			g.last = cur
+			g.seq++
+		}
+		g.mu.Unlock()
+		close(cur.done)
+	}()
+
+	text, err = resolve(ctx, img)
+	if err != nil {
+		return "", "", err
+	}
+	cur.text = text
+	cur.ok = true
+	return sid, text, nil
+}
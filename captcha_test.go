@@ -0,0 +1,129 @@
+package vk_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/gobwas/vk"
+	"github.com/gobwas/vk/vktest"
+)
+
+func TestCaptchaGate(t *testing.T) {
+	const n = 8
+
+	srv := vktest.NewServer(&vktest.State{
+		Users: []vk.User{{ID: 1}},
+	})
+	defer srv.Close()
+	srv.Fail("users.get", vk.ErrCaptchaRequired, 1000)
+
+	var (
+		asked int32
+		gate  vk.CaptchaGate
+		token = srv.Token(1)
+		ctx   = context.Background()
+	)
+	resolve := func(ctx context.Context, img string) (string, error) {
+		atomic.AddInt32(&asked, 1)
+		// Wait for all of the calls to hit captcha.
+		for deadline := time.Now().Add(time.Second); len(srv.Calls()) < n; {
+			if time.Now().After(deadline) {
+				t.Errorf("calls did not hit captcha")
+				break
+			}
+			time.Sleep(time.Millisecond)
+		}
+		return srv.CaptchaKey, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := vk.Caller{
+				Client:         srv.Client(),
+				Method:         "users.get",
+				Options:        vk.QueryOptions(vk.WithAccessToken(token)),
+				Limiter:        rate.NewLimiter(rate.Inf, 1),
+				Retry:          vk.NoRetry,
+				ResolveCaptcha: resolve,
+				CaptchaGate:    &gate,
+			}
+			if _, err := c.Call(ctx); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := atomic.LoadInt32(&asked); n != 1 {
+		t.Errorf("captcha was asked %d times; want 1", n)
+	}
+	if act, exp := len(srv.Calls()), 2*n; act != exp {
+		t.Errorf("server received %d calls; want %d", act, exp)
+	}
+}
+
+func TestCallerCaptcha(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		answer string
+		asked  int
+		calls  int
+		err    error
+	}{
+		{
+			name:   "answered",
+			answer: "captcha",
+			asked:  1,
+			calls:  2,
+		},
+		{
+			name:   "wrong answer",
+			answer: "wrong",
+			asked:  2,
+			calls:  3,
+			err:    vk.ErrCaptchaRequired,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			srv := vktest.NewServer(&vktest.State{
+				Users: []vk.User{{ID: 1}},
+			})
+			defer srv.Close()
+			srv.CaptchaKey = "captcha"
+			srv.Fail("users.get", vk.ErrCaptchaRequired, 1000)
+
+			var asked int
+			c := vk.Caller{
+				Client:  srv.Client(),
+				Method:  "users.get",
+				Options: vk.QueryOptions(vk.WithAccessToken(srv.Token(1))),
+				Limiter: rate.NewLimiter(rate.Inf, 1),
+				Retry:   vk.NoRetry,
+				ResolveCaptcha: func(ctx context.Context, img string) (string, error) {
+					asked++
+					return test.answer, nil
+				},
+				CaptchaAttempts: 2,
+			}
+			_, err := c.Call(context.Background())
+			if !errors.Is(err, test.err) {
+				t.Errorf("unexpected error: %v; want %v", err, test.err)
+			}
+			if asked != test.asked {
+				t.Errorf("captcha was asked %d times; want %d", asked, test.asked)
+			}
+			if n := len(srv.Calls()); n != test.calls {
+				t.Errorf("server received %d calls; want %d", n, test.calls)
+			}
+		})
+	}
+}
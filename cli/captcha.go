@@ -0,0 +1,442 @@
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/gobwas/vk/internal/httputil"
+)
+
+// CaptchaSolver returns text from captcha image located at img url.
+type CaptchaSolver interface {
+	SolveCaptcha(ctx context.Context, img string) (text string, err error)
+}
+
+// CaptchaSolverFunc is an adapter to use ordinary functions as CaptchaSolver.
+type CaptchaSolverFunc func(ctx context.Context, img string) (string, error)
+
+// SolveCaptcha implements CaptchaSolver.
+func (f CaptchaSolverFunc) SolveCaptcha(ctx context.Context, img string) (string, error) {
+	return f(ctx, img)
+}
+
+// BrowserSolver opens captcha image in a web browser and asks for the answer
+// on terminal. It is the same as ResolveCaptcha().
+type BrowserSolver struct{}
+
+// SolveCaptcha implements CaptchaSolver.
+func (BrowserSolver) SolveCaptcha(ctx context.Context, img string) (string, error) {
+	return ResolveCaptcha(ctx, img)
+}
+
+// TerminalSolver draws captcha image right in the terminal and asks for the
+// answer. It works over ssh.
+type TerminalSolver struct {
+	// Out is where image is drawn. If nil, os.Stderr is used.
+	Out io.Writer
+
+	// Width is the maximum width of the image in columns. If zero, 80 is
+	// used. It is ignored if Sixel is true.
+	Width int
+
+	// Sixel makes image drawn as sixel graphics. Otherwise image is drawn
+	// with ANSI true color half blocks.
+	Sixel bool
+
+	// Client is used to download image. If nil, http.DefaultClient is used.
+	Client *http.Client
+}
+
+// SolveCaptcha implements CaptchaSolver.
+func (t *TerminalSolver) SolveCaptcha(ctx context.Context, img string) (string, error) {
+	m, err := fetchImage(ctx, t.Client, img)
+	if err != nil {
+		return "", err
+	}
+	out := t.Out
+	if out == nil {
+		out = os.Stderr
+	}
+	w := bufio.NewWriter(out)
+	if t.Sixel {
+		writeSixel(w, m)
+	} else {
+		width := t.Width
+		if width <= 0 {
+			width = 80
+		}
+		writeBlocks(w, m, width)
+	}
+	if err := w.Flush(); err != nil {
+		return "", err
+	}
+	return Ask(ctx, "enter captcha text: ")
+}
+
+// FileSolver saves captcha image to a file and waits for the answer to be
+// written to another file or named pipe. It works when there is no terminal
+// at all, e.g. for commands run by cron.
+type FileSolver struct {
+	// Image is a path where captcha image is saved.
+	Image string
+
+	// Answer is a path of the file or named pipe to read answer from. If
+	// empty, Image with ".txt" suffix is used. Regular answer file is
+	// removed after it is read.
+	Answer string
+
+	// Poll is an interval of answer file existence checks. If zero, one
+	// second is used.
+	Poll time.Duration
+
+	// Out is where paths of the image and answer files are printed. If nil,
+	// os.Stderr is used.
+	Out io.Writer
+
+	// Client is used to download image. If nil, http.DefaultClient is used.
+	Client *http.Client
+}
+
+// SolveCaptcha implements CaptchaSolver.
+func (f *FileSolver) SolveCaptcha(ctx context.Context, img string) (string, error) {
+	if f.Image == "" {
+		return "", fmt.Errorf("captcha image path is not set")
+	}
+	answer := f.Answer
+	if answer == "" {
+		answer = f.Image + ".txt"
+	}
+	// Drop stale answer left from the previous captcha.
+	if fi, err := os.Stat(answer); err == nil && fi.Mode().IsRegular() {
+		if err := os.Remove(answer); err != nil {
+			return "", err
+		}
+	}
+	bts, err := fetch(ctx, f.Client, img)
+	if err != nil {
+		return "", err
+	}
+	if err := ioutil.WriteFile(f.Image, bts, 0600); err != nil {
+		return "", err
+	}
+	out := f.Out
+	if out == nil {
+		out = os.Stderr
+	}
+	fmt.Fprintf(out,
+		"captcha image saved to %s; write the answer to %s\n",
+		f.Image, answer,
+	)
+
+	poll := f.Poll
+	if poll <= 0 {
+		poll = time.Second
+	}
+	tick := time.NewTicker(poll)
+	defer tick.Stop()
+	for {
+		fi, err := os.Stat(answer)
+		switch {
+		case err == nil && fi.Mode()&os.ModeNamedPipe != 0:
+			return readPipe(ctx, answer)
+
+		case err == nil:
+			bts, err := ioutil.ReadFile(answer)
+			if err != nil {
+				return "", err
+			}
+			if err := os.Remove(answer); err != nil {
+				return "", err
+			}
+			return strings.TrimSpace(string(bts)), nil
+
+		case !os.IsNotExist(err):
+			return "", err
+		}
+		select {
+		case <-tick.C:
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+}
+
+// CommandSolver runs external command with captcha image url appended as the
+// last argument. Command must print the answer to its stdout.
+type CommandSolver struct {
+	Command string
+}
+
+// SolveCaptcha implements CaptchaSolver.
+func (s *CommandSolver) SolveCaptcha(ctx context.Context, img string) (string, error) {
+	args := strings.Fields(s.Command)
+	if len(args) == 0 {
+		return "", fmt.Errorf("empty captcha command")
+	}
+	cmd := exec.CommandContext(ctx, args[0], append(args[1:], img)...)
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("captcha command: %v", err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// Names of captcha solvers accepted by CaptchaConfig.
+const (
+	CaptchaAuto     = "auto"
+	CaptchaBrowser  = "browser"
+	CaptchaTerminal = "terminal"
+	CaptchaSixel    = "sixel"
+	CaptchaFile     = "file"
+	CaptchaCommand  = "command"
+)
+
+// CaptchaConfig describes CaptchaSolver selected by command line flags.
+type CaptchaConfig struct {
+	Solver  string
+	Path    string
+	Command string
+}
+
+func (c *CaptchaConfig) ExportTo(flag *flag.FlagSet) {
+	flag.StringVar(&c.Solver,
+		"captcha", CaptchaAuto,
+		"captcha solver: auto, browser, terminal, sixel, file or command",
+	)
+	flag.StringVar(&c.Path,
+		"captcha_path", "",
+		"path to save captcha image for file solver (empty for default)",
+	)
+	flag.StringVar(&c.Command,
+		"captcha_command", "",
+		"captcha solver command",
+	)
+}
+
+// CaptchaSolver returns CaptchaSolver described by c.
+//
+// The auto solver uses command if it is set. Otherwise it uses file solver
+// when stdin is not a terminal, web browser when there is a local display and
+// terminal drawing in other cases (e.g. over ssh).
+func (c *CaptchaConfig) CaptchaSolver() (CaptchaSolver, error) {
+	name := c.Solver
+	if name == "" || name == CaptchaAuto {
+		switch {
+		case c.Command != "":
+			name = CaptchaCommand
+		case !isTerminal(os.Stdin):
+			name = CaptchaFile
+		case hasDisplay():
+			name = CaptchaBrowser
+		default:
+			name = CaptchaTerminal
+		}
+	}
+	switch name {
+	case CaptchaBrowser:
+		return BrowserSolver{}, nil
+
+	case CaptchaTerminal:
+		return &TerminalSolver{}, nil
+
+	case CaptchaSixel:
+		return &TerminalSolver{Sixel: true}, nil
+
+	case CaptchaFile:
+		path := c.Path
+		if path == "" {
+			path = filepath.Join(os.TempDir(), "vk-captcha.jpg")
+		}
+		return &FileSolver{Image: path}, nil
+
+	case CaptchaCommand:
+		if c.Command == "" {
+			return nil, fmt.Errorf("captcha_command is required for %q solver", name)
+		}
+		return &CommandSolver{Command: c.Command}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown captcha solver: %q", name)
+	}
+}
+
+func isTerminal(f *os.File) bool {
+	fi, err := f.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
+
+func hasDisplay() bool {
+	if os.Getenv("SSH_CONNECTION") != "" {
+		return false
+	}
+	switch runtime.GOOS {
+	case "windows", "darwin":
+		return true
+	default:
+		return os.Getenv("DISPLAY") != "" || os.Getenv("WAYLAND_DISPLAY") != ""
+	}
+}
+
+func fetch(ctx context.Context, client *http.Client, u string) ([]byte, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if err := httputil.CheckResponseStatus(resp); err != nil {
+		return nil, err
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+func fetchImage(ctx context.Context, client *http.Client, u string) (image.Image, error) {
+	bts, err := fetch(ctx, client, u)
+	if err != nil {
+		return nil, err
+	}
+	m, _, err := image.Decode(bytes.NewReader(bts))
+	if err != nil {
+		return nil, fmt.Errorf("decode captcha image: %v", err)
+	}
+	return m, nil
+}
+
+// readPipe reads a line from the named pipe at path. Note that if ctx is
+// canceled before any writer opens the pipe, reading goroutine is left
+// blocked until then.
+func readPipe(ctx context.Context, path string) (string, error) {
+	ch := make(chan stringAndError, 1)
+	go func() {
+		f, err := os.Open(path)
+		if err != nil {
+			ch <- stringAndError{"", err}
+			return
+		}
+		defer f.Close()
+		line, err := bufio.NewReader(f).ReadString('\n')
+		if err == io.EOF && line != "" {
+			err = nil
+		}
+		ch <- stringAndError{strings.TrimSpace(line), err}
+	}()
+	select {
+	case r := <-ch:
+		return r.str, r.err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+// writeBlocks draws m with upper half block characters, so every character
+// cell holds two vertical pixels.
+func writeBlocks(w *bufio.Writer, m image.Image, width int) {
+	b := m.Bounds()
+	scale := 1
+	for b.Dx()/scale > width {
+		scale++
+	}
+	for y := b.Min.Y; y < b.Max.Y; y += 2 * scale {
+		for x := b.Min.X; x < b.Max.X; x += scale {
+			r1, g1, b1 := rgb(m.At(x, y))
+			r2, g2, b2 := r1, g1, b1
+			if y+scale < b.Max.Y {
+				r2, g2, b2 = rgb(m.At(x, y+scale))
+			}
+			fmt.Fprintf(w,
+				"\x1b[38;2;%d;%d;%dm\x1b[48;2;%d;%d;%dm▀",
+				r1, g1, b1, r2, g2, b2,
+			)
+		}
+		w.WriteString("\x1b[0m\n")
+	}
+}
+
+// writeSixel draws m as sixel graphics using 6x6x6 color cube palette.
+func writeSixel(w *bufio.Writer, m image.Image) {
+	b := m.Bounds()
+	fmt.Fprintf(w, "\x1bPq\"1;1;%d;%d", b.Dx(), b.Dy())
+	for i := 0; i < 216; i++ {
+		fmt.Fprintf(w, "#%d;2;%d;%d;%d", i,
+			(i/36)*100/5, (i/6%6)*100/5, (i%6)*100/5,
+		)
+	}
+	row := make([]byte, b.Dx())
+	for y := b.Min.Y; y < b.Max.Y; y += 6 {
+		var used [216]bool
+		for dy := 0; dy < 6 && y+dy < b.Max.Y; dy++ {
+			for x := b.Min.X; x < b.Max.X; x++ {
+				used[paletteIndex(m.At(x, y+dy))] = true
+			}
+		}
+		for c := range used {
+			if !used[c] {
+				continue
+			}
+			for x := b.Min.X; x < b.Max.X; x++ {
+				var bits byte
+				for dy := 0; dy < 6 && y+dy < b.Max.Y; dy++ {
+					if paletteIndex(m.At(x, y+dy)) == c {
+						bits |= 1 << uint(dy)
+					}
+				}
+				row[x-b.Min.X] = '?' + bits
+			}
+			fmt.Fprintf(w, "#%d", c)
+			writeSixelRow(w, row)
+			w.WriteByte('$')
+		}
+		w.WriteByte('-')
+	}
+	w.WriteString("\x1b\\\n")
+}
+
+// writeSixelRow writes row using run length encoding.
+func writeSixelRow(w *bufio.Writer, row []byte) {
+	for i := 0; i < len(row); {
+		j := i + 1
+		for j < len(row) && row[j] == row[i] {
+			j++
+		}
+		if n := j - i; n > 3 {
+			fmt.Fprintf(w, "!%d%c", n, row[i])
+		} else {
+			w.Write(row[i:j])
+		}
+		i = j
+	}
+}
+
+func paletteIndex(c color.Color) int {
+	r, g, b := rgb(c)
+	return int(r)*6/256*36 + int(g)*6/256*6 + int(b)*6/256
+}
+
+func rgb(c color.Color) (r, g, b uint8) {
+	r32, g32, b32, _ := c.RGBA()
+	return uint8(r32 >> 8), uint8(g32 >> 8), uint8(b32 >> 8)
+}
@@ -57,7 +57,66 @@ func AuthorizeStandalone(ctx context.Context, app vk.App) (*vk.AccessToken, erro
 		return nil, err
 	}
 
-	return vk.TokenFromURL(str)
+	token, err := vk.TokenFromURL(str)
+	if err != nil {
+		return nil, err
+	}
+	token.Scope = app.Scope
+
+	return token, nil
+}
+
+// DirectOptions contains optional parameters of AuthorizeDirect().
+type DirectOptions struct {
+	// Code is a two-factor authentication code. It is sent only once.
+	Code string
+
+	// AskCode makes AuthorizeDirect() ask for two-factor authentication code
+	// on terminal if Code is empty or rejected. If false, validation
+	// challenge results in *vk.OAuthError with "need_validation" error.
+	AskCode bool
+
+	// Captcha solves captcha challenges. If nil, captcha challenge results
+	// in *vk.OAuthError with "need_captcha" error.
+	Captcha CaptchaSolver
+}
+
+// AuthorizeDirect authorizes app by username and password without a web
+// browser. It never prompts for anything unless opts allow it to.
+func AuthorizeDirect(ctx context.Context, app vk.App, username, password string, opts DirectOptions) (*vk.AccessToken, error) {
+	code := opts.Code
+	direct := vk.DirectOptions{
+		Code: func(ctx context.Context, v *vk.Validation) (string, error) {
+			if code != "" {
+				// Code is valid only once.
+				c := code
+				code = ""
+				return c, nil
+			}
+			if !opts.AskCode {
+				return "", &vk.OAuthError{
+					Err:         "need_validation",
+					Description: "validation code was rejected",
+				}
+			}
+			return Ask(ctx, fmt.Sprintf(
+				"enter validation code (%s %s): ", v.Type, v.PhoneMask,
+			))
+		},
+	}
+	if !opts.AskCode && code == "" {
+		direct.Code = nil
+	}
+	if opts.Captcha != nil {
+		direct.ResolveCaptcha = opts.Captcha.SolveCaptcha
+	}
+	return app.AuthorizeDirect(ctx, username, password, &direct)
+}
+
+// Interactive reports whether stdin is a terminal, that is, whether it is
+// safe to ask user for input.
+func Interactive() bool {
+	return isTerminal(os.Stdin)
 }
 
 func redirectServer(ctx context.Context, redirect chan<- requestAndError) (uri string, err error) {
@@ -130,6 +189,21 @@ func Ask(ctx context.Context, question string) (string, error) {
 	}
 }
 
+// AskPassword is like Ask but does not display entered characters.
+func AskPassword(ctx context.Context, question string) (string, error) {
+	echo := exec.Command("stty", "-echo")
+	echo.Stdin = os.Stdin
+	if err := echo.Run(); err == nil {
+		defer func() {
+			echo := exec.Command("stty", "echo")
+			echo.Stdin = os.Stdin
+			echo.Run()
+			fmt.Fprint(os.Stderr, "\n")
+		}()
+	}
+	return Ask(ctx, question)
+}
+
 func AskRune(ctx context.Context, question string) (rune, error) {
 	// disable input buffering
 	err := exec.Command("stty", "-f", "/dev/tty", "cbreak", "min", "1").Run()
@@ -0,0 +1,232 @@
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gobwas/vk"
+)
+
+// CommandStore is a TokenStore which delegates to an external helper
+// command, much like git credential helpers do.
+//
+// The helper is run with one of "get", "store", "erase" or "list" arguments
+// appended to the Command. Attributes are passed as key=value lines to the
+// helper's stdin and read from its stdout in the same format. Known keys are
+// profile, client_id, access_token, user_id, expires (unix time, 0 for never),
+// scope, kind (user, community or service; user if missing), group_id and
+// group_scope.
+//
+// For "get" the helper prints token attributes or nothing if there is no
+// token. For "erase" the helper may print deleted=N line with number of
+// removed tokens; otherwise Delete() returns DeletedUnknown. For "list" the
+// helper prints tokens separated by empty lines.
+type CommandStore struct {
+	Command string
+}
+
+// Load implements TokenStore.
+func (s *CommandStore) Load(profile, clientID string) (*vk.AccessToken, error) {
+	out, err := s.run("get", attrs{
+		"profile":   profileName(profile),
+		"client_id": clientID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(out) == 0 || out[0]["access_token"] == "" {
+		return nil, nil
+	}
+	return out[0].token()
+}
+
+// Save implements TokenStore.
+func (s *CommandStore) Save(profile, clientID string, token *vk.AccessToken) error {
+	var expires int64
+	if !token.Expires.IsZero() {
+		expires = token.Expires.Unix()
+	}
+	_, err := s.run("store", attrs{
+		"profile":      profileName(profile),
+		"client_id":    clientID,
+		"access_token": token.Token,
+		"user_id":      strconv.Itoa(token.UserID),
+		"expires":      strconv.FormatInt(expires, 10),
+		"scope":        token.Scope.String(),
+		"kind":         token.Kind.String(),
+		"group_id":     strconv.Itoa(token.GroupID),
+		"group_scope":  token.GroupScope.String(),
+	})
+	return err
+}
+
+// Delete implements TokenStore.
+func (s *CommandStore) Delete(profile, clientID string) (int, error) {
+	out, err := s.run("erase", attrs{
+		"profile":   profileName(profile),
+		"client_id": clientID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(out) == 0 || out[0]["deleted"] == "" {
+		// Helper did not report the number of removed tokens.
+		return DeletedUnknown, nil
+	}
+	return strconv.Atoi(out[0]["deleted"])
+}
+
+// List implements TokenStore.
+func (s *CommandStore) List(profile string) (ts []StoredToken, err error) {
+	out, err := s.run("list", attrs{
+		"profile": profile,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range out {
+		token, err := a.token()
+		if err != nil {
+			return nil, err
+		}
+		ts = append(ts, StoredToken{
+			Profile:  a["profile"],
+			ClientID: a["client_id"],
+			Token:    token,
+		})
+	}
+	return ts, nil
+}
+
+func (s *CommandStore) run(op string, in attrs) ([]attrs, error) {
+	args := strings.Fields(s.Command)
+	if len(args) == 0 {
+		return nil, fmt.Errorf("empty store command")
+	}
+	cmd := exec.Command(args[0], append(args[1:], op)...)
+	cmd.Stdin = strings.NewReader(in.String())
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("store command %s: %v", op, err)
+	}
+	return parseAttrs(out)
+}
+
+type attrs map[string]string
+
+func (a attrs) String() string {
+	keys := make([]string, 0, len(a))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	for _, key := range keys {
+		value := a[key]
+		if value == "" {
+			continue
+		}
+		sb.WriteString(key)
+		sb.WriteByte('=')
+		sb.WriteString(value)
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+func (a attrs) token() (*vk.AccessToken, error) {
+	userID, err := a.int("user_id")
+	if err != nil {
+		return nil, err
+	}
+	expires, err := a.int("expires")
+	if err != nil {
+		return nil, err
+	}
+	scope, err := a.int("scope")
+	if err != nil {
+		return nil, err
+	}
+	groupID, err := a.int("group_id")
+	if err != nil {
+		return nil, err
+	}
+	groupScope, err := a.int("group_scope")
+	if err != nil {
+		return nil, err
+	}
+	kind, err := a.kind()
+	if err != nil {
+		return nil, err
+	}
+	token := &vk.AccessToken{
+		Token:      a["access_token"],
+		UserID:     int(userID),
+		Scope:      vk.Scope(scope),
+		Kind:       kind,
+		GroupID:    int(groupID),
+		GroupScope: vk.GroupScope(groupScope),
+	}
+	if expires != 0 {
+		token.Expires = time.Unix(expires, 0)
+	}
+	return token, nil
+}
+
+func (a attrs) kind() (vk.TokenKind, error) {
+	for _, kind := range []vk.TokenKind{
+		vk.TokenUser,
+		vk.TokenCommunity,
+		vk.TokenService,
+	} {
+		if a["kind"] == kind.String() {
+			return kind, nil
+		}
+	}
+	if a["kind"] == "" {
+		return vk.TokenUser, nil
+	}
+	return 0, fmt.Errorf("bad kind value: %q", a["kind"])
+}
+
+func (a attrs) int(key string) (int64, error) {
+	if a[key] == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(a[key], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("bad %s value: %v", key, err)
+	}
+	return n, nil
+}
+
+func parseAttrs(p []byte) (ret []attrs, err error) {
+	var cur attrs
+	s := bufio.NewScanner(bytes.NewReader(p))
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" {
+			cur = nil
+			continue
+		}
+		i := strings.IndexByte(line, '=')
+		if i == -1 {
+			return nil, fmt.Errorf("malformed helper output line: %q", line)
+		}
+		if cur == nil {
+			cur = make(attrs)
+			ret = append(ret, cur)
+		}
+		cur[line[:i]] = line[i+1:]
+	}
+	return ret, s.Err()
+}
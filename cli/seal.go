@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// Key derivation parameters of sealed data.
+const (
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+
+	keySize  = 32
+	saltSize = 16
+)
+
+const sealedKDF = "scrypt"
+
+// sealed is an envelope of data encrypted with AES-256-GCM using a key
+// derived from passphrase with scrypt.
+type sealed struct {
+	KDF   string `json:"kdf"`
+	N     int    `json:"n"`
+	R     int    `json:"r"`
+	P     int    `json:"p"`
+	Salt  []byte `json:"salt"`
+	Nonce []byte `json:"nonce"`
+	Data  []byte `json:"data"`
+}
+
+func seal(data, pass []byte) ([]byte, error) {
+	s := sealed{
+		KDF:  sealedKDF,
+		N:    scryptN,
+		R:    scryptR,
+		P:    scryptP,
+		Salt: make([]byte, saltSize),
+	}
+	if _, err := rand.Read(s.Salt); err != nil {
+		return nil, err
+	}
+	aead, err := s.aead(pass)
+	if err != nil {
+		return nil, err
+	}
+	s.Nonce = make([]byte, aead.NonceSize())
+	if _, err := rand.Read(s.Nonce); err != nil {
+		return nil, err
+	}
+	s.Data = aead.Seal(nil, s.Nonce, data, []byte(sealedKDF))
+
+	return json.MarshalIndent(s, "", "\t")
+}
+
+func unseal(bts, pass []byte) ([]byte, error) {
+	var s sealed
+	if err := json.Unmarshal(bts, &s); err != nil {
+		return nil, err
+	}
+	if s.KDF != sealedKDF {
+		return nil, fmt.Errorf("unsupported key derivation function: %q", s.KDF)
+	}
+	aead, err := s.aead(pass)
+	if err != nil {
+		return nil, err
+	}
+	if len(s.Nonce) != aead.NonceSize() {
+		return nil, fmt.Errorf("malformed nonce")
+	}
+	data, err := aead.Open(nil, s.Nonce, s.Data, []byte(sealedKDF))
+	if err != nil {
+		return nil, fmt.Errorf("wrong passphrase or corrupted data")
+	}
+	return data, nil
+}
+
+func (s *sealed) aead(pass []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key(pass, s.Salt, s.N, s.R, s.P, keySize)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// isSealed reports whether bts looks like data returned by seal().
+func isSealed(bts []byte) bool {
+	var s sealed
+	return json.Unmarshal(bts, &s) == nil && s.KDF != ""
+}
@@ -0,0 +1,524 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gobwas/vk"
+)
+
+// DefaultProfile is the name of profile used when no profile is given.
+const DefaultProfile = "default"
+
+// ExpiryMargin is the minimum lifetime left for stored token to be reused.
+var ExpiryMargin = time.Minute
+
+// DeletedUnknown is returned by TokenStore.Delete() when number of removed
+// tokens is unknown.
+const DeletedUnknown = -1
+
+// TokenStore is a storage of access tokens. Tokens are keyed by profile name
+// and application client id.
+type TokenStore interface {
+	// Load returns token stored for given profile and client id. It returns
+	// nil token and nil error if there is no such token.
+	Load(profile, clientID string) (*vk.AccessToken, error)
+
+	// Save stores token for given profile and client id.
+	Save(profile, clientID string, token *vk.AccessToken) error
+
+	// Delete removes tokens of given profile. If clientID is empty, all
+	// tokens of the profile are removed. It returns number of removed tokens
+	// or DeletedUnknown if the store could not tell it.
+	Delete(profile, clientID string) (int, error)
+
+	// List returns tokens stored for given profile. If profile is empty,
+	// tokens of all profiles are returned.
+	List(profile string) ([]StoredToken, error)
+}
+
+// StoredToken describes a token saved in TokenStore.
+type StoredToken struct {
+	Profile  string
+	ClientID string
+	Token    *vk.AccessToken
+}
+
+// FileStore is a file-backed TokenStore.
+type FileStore struct {
+	// Path is a path to the store file. If empty, "vk/tokens.json" under
+	// the user config dir is used.
+	Path string
+
+	// Passphrase returns passphrase used to encrypt the file. If nil, file is
+	// stored in plaintext. Plaintext file is encrypted on the first write
+	// when Passphrase is set.
+	Passphrase func() ([]byte, error)
+
+	mu sync.Mutex
+}
+
+type storedToken struct {
+	Token   string       `json:"access_token"`
+	UserID  int          `json:"user_id"`
+	Expires time.Time    `json:"expires"`
+	Scope   vk.Scope     `json:"scope"`
+	Kind    vk.TokenKind `json:"kind,omitempty"`
+
+	GroupID    int           `json:"group_id,omitempty"`
+	GroupScope vk.GroupScope `json:"group_scope,omitempty"`
+}
+
+func newStoredToken(t *vk.AccessToken) storedToken {
+	return storedToken{
+		Token:   t.Token,
+		UserID:  t.UserID,
+		Expires: t.Expires,
+		Scope:   t.Scope,
+		Kind:    t.Kind,
+
+		GroupID:    t.GroupID,
+		GroupScope: t.GroupScope,
+	}
+}
+
+func (t storedToken) accessToken() *vk.AccessToken {
+	return &vk.AccessToken{
+		Token:   t.Token,
+		UserID:  t.UserID,
+		Expires: t.Expires,
+		Scope:   t.Scope,
+		Kind:    t.Kind,
+
+		GroupID:    t.GroupID,
+		GroupScope: t.GroupScope,
+	}
+}
+
+// profile -> client_id -> token
+type tokenFile map[string]map[string]storedToken
+
+// Load implements TokenStore.
+func (s *FileStore) Load(profile, clientID string) (*vk.AccessToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+	t, ok := f[profileName(profile)][clientID]
+	if !ok {
+		return nil, nil
+	}
+	return t.accessToken(), nil
+}
+
+// Save implements TokenStore.
+func (s *FileStore) Save(profile, clientID string, token *vk.AccessToken) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := s.read()
+	if err != nil {
+		return err
+	}
+	profile = profileName(profile)
+	if f[profile] == nil {
+		f[profile] = make(map[string]storedToken)
+	}
+	f[profile][clientID] = newStoredToken(token)
+	return s.write(f)
+}
+
+// Delete implements TokenStore.
+func (s *FileStore) Delete(profile, clientID string) (n int, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := s.read()
+	if err != nil {
+		return 0, err
+	}
+	profile = profileName(profile)
+	for id := range f[profile] {
+		if clientID == "" || id == clientID {
+			delete(f[profile], id)
+			n++
+		}
+	}
+	if len(f[profile]) == 0 {
+		delete(f, profile)
+	}
+	if n == 0 {
+		return 0, nil
+	}
+	return n, s.write(f)
+}
+
+// List implements TokenStore. Tokens are sorted by profile and client id.
+func (s *FileStore) List(profile string) (ts []StoredToken, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+	for p, tokens := range f {
+		if profile != "" && p != profile {
+			continue
+		}
+		for id, t := range tokens {
+			ts = append(ts, StoredToken{
+				Profile:  p,
+				ClientID: id,
+				Token:    t.accessToken(),
+			})
+		}
+	}
+	sort.Slice(ts, func(i, j int) bool {
+		if ts[i].Profile != ts[j].Profile {
+			return ts[i].Profile < ts[j].Profile
+		}
+		return ts[i].ClientID < ts[j].ClientID
+	})
+	return ts, nil
+}
+
+func (s *FileStore) path() (string, error) {
+	if s.Path != "" {
+		return s.Path, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "vk", "tokens.json"), nil
+}
+
+func (s *FileStore) read() (tokenFile, error) {
+	path, err := s.path()
+	if err != nil {
+		return nil, err
+	}
+	f := make(tokenFile)
+	bts, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return f, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if isSealed(bts) {
+		if s.Passphrase == nil {
+			return nil, fmt.Errorf("token store %s is encrypted", path)
+		}
+		pass, err := s.Passphrase()
+		if err != nil {
+			return nil, err
+		}
+		if bts, err = unseal(bts, pass); err != nil {
+			return nil, fmt.Errorf("decrypt token store %s: %v", path, err)
+		}
+	}
+	if err := json.Unmarshal(bts, &f); err != nil {
+		return nil, fmt.Errorf("parse token store %s: %v", path, err)
+	}
+	return f, nil
+}
+
+func (s *FileStore) write(f tokenFile) error {
+	path, err := s.path()
+	if err != nil {
+		return err
+	}
+	bts, err := json.MarshalIndent(f, "", "\t")
+	if err != nil {
+		return err
+	}
+	if s.Passphrase != nil {
+		pass, err := s.Passphrase()
+		if err != nil {
+			return err
+		}
+		if bts, err = seal(bts, pass); err != nil {
+			return err
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	// Write to temporary file first to not corrupt the store if something
+	// goes wrong.
+	tmp, err := ioutil.TempFile(filepath.Dir(path), ".tokens")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if err := tmp.Chmod(0600); err != nil {
+		tmp.Close()
+		return err
+	}
+	if _, err := tmp.Write(bts); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func profileName(profile string) string {
+	if profile == "" {
+		return DefaultProfile
+	}
+	return profile
+}
+
+// Environment variables used by EnvStore.
+const (
+	EnvAccessToken = "VK_ACCESS_TOKEN"
+	EnvUserID      = "VK_USER_ID"
+)
+
+// EnvStore is a read-only TokenStore which passes through the token set by
+// EnvAccessToken and EnvUserID environment variables for any profile and
+// client id. Save and Delete do nothing.
+//
+// Scope of such token is unknown, thus it is assumed to have any scope and
+// missing permissions are reported by the API.
+type EnvStore struct{}
+
+// Load implements TokenStore.
+func (EnvStore) Load(profile, clientID string) (*vk.AccessToken, error) {
+	token := os.Getenv(EnvAccessToken)
+	if token == "" {
+		return nil, nil
+	}
+	var userID int
+	if s := os.Getenv(EnvUserID); s != "" {
+		var err error
+		if userID, err = strconv.Atoi(s); err != nil {
+			return nil, fmt.Errorf("bad %s value: %v", EnvUserID, err)
+		}
+	}
+	return &vk.AccessToken{
+		Token:  token,
+		UserID: userID,
+		Scope:  ^vk.Scope(0),
+	}, nil
+}
+
+// Save implements TokenStore.
+func (EnvStore) Save(profile, clientID string, token *vk.AccessToken) error {
+	return nil
+}
+
+// Delete implements TokenStore.
+func (EnvStore) Delete(profile, clientID string) (int, error) {
+	return 0, nil
+}
+
+// List implements TokenStore.
+func (e EnvStore) List(profile string) ([]StoredToken, error) {
+	token, err := e.Load(profile, "")
+	if err != nil || token == nil {
+		return nil, err
+	}
+	return []StoredToken{{
+		Profile:  profileName(profile),
+		ClientID: "env",
+		Token:    token,
+	}}, nil
+}
+
+// Names of token stores accepted by StoreConfig.
+const (
+	StoreFile      = "file"
+	StoreEncrypted = "encrypted"
+	StoreEnv       = "env"
+	StoreCommand   = "command"
+)
+
+// EnvPassphrase is the name of environment variable holding passphrase of
+// encrypted token store. If it is not set, passphrase is asked from terminal.
+const EnvPassphrase = "VK_STORE_PASSPHRASE"
+
+// StoreConfig describes TokenStore selected by command line flags.
+type StoreConfig struct {
+	Store   string
+	Path    string
+	Command string
+
+	// Log receives warnings of Login(). If nil, warnings are discarded.
+	Log io.Writer
+}
+
+func (c *StoreConfig) ExportTo(flag *flag.FlagSet) {
+	flag.StringVar(&c.Store,
+		"token_store", StoreFile,
+		"token store: file, encrypted, env or command",
+	)
+	flag.StringVar(&c.Path,
+		"token_store_path", "",
+		"token store file path (empty for default)",
+	)
+	flag.StringVar(&c.Command,
+		"token_store_command", "",
+		"token store helper command",
+	)
+}
+
+// Open returns TokenStore described by c.
+func (c *StoreConfig) Open(ctx context.Context) (TokenStore, error) {
+	switch c.Store {
+	case "", StoreFile:
+		return &FileStore{Path: c.Path}, nil
+
+	case StoreEncrypted:
+		var (
+			once sync.Once
+			pass []byte
+			err  error
+		)
+		return &FileStore{
+			Path: c.Path,
+			Passphrase: func() ([]byte, error) {
+				once.Do(func() {
+					if s := os.Getenv(EnvPassphrase); s != "" {
+						pass = []byte(s)
+						return
+					}
+					var s string
+					s, err = AskPassword(ctx, "token store passphrase: ")
+					pass = []byte(s)
+				})
+				return pass, err
+			},
+		}, nil
+
+	case StoreEnv:
+		return EnvStore{}, nil
+
+	case StoreCommand:
+		if c.Command == "" {
+			return nil, fmt.Errorf("token_store_command is required for %q store", c.Store)
+		}
+		return &CommandStore{Command: c.Command}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown token store: %q", c.Store)
+	}
+}
+
+// Login opens the store and calls Login() with it.
+func (c *StoreConfig) Login(ctx context.Context, profile string, app vk.App) (*vk.AccessToken, error) {
+	store, err := c.Open(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return Login(ctx, store, profile, app, c.Log)
+}
+
+// Usable reports whether token could be used for calls which require given
+// scope.
+func Usable(token *vk.AccessToken, scope vk.Scope) bool {
+	if !token.Valid() {
+		return false
+	}
+	if !token.Scope.Has(scope) {
+		return false
+	}
+	return token.Expires.IsZero() || time.Until(token.Expires) > ExpiryMargin
+}
+
+// Login returns token stored for given profile and app if it is usable and
+// has app's scope granted. Otherwise it authorizes app with
+// AuthorizeStandalone() and saves received token to the store. Permissions
+// of the stored token are requested too, so the saved token keeps them.
+//
+// Warnings, e.g. when received token could not be saved, are written to log
+// if it is not nil.
+//
+// It returns *vk.ScopeError if app's scope was not granted even after
+// authorization.
+func Login(ctx context.Context, store TokenStore, profile string, app vk.App, log io.Writer) (*vk.AccessToken, error) {
+	token, err := store.Load(profile, app.ClientID)
+	if err != nil {
+		return nil, err
+	}
+	if Usable(token, app.Scope) {
+		err := checkScope(ctx, app, token)
+		if err == nil {
+			return token, nil
+		}
+		if !vk.AuthError(err) && !errors.As(err, new(*vk.ScopeError)) {
+			return nil, err
+		}
+		warnf(log, "stored token could not be used: %v", err)
+	}
+	auth := app
+	if token != nil {
+		auth.Scope |= knownScope(token.Scope)
+	}
+	token, err = AuthorizeStandalone(ctx, auth)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkScope(ctx, app, token); err != nil {
+		return nil, err
+	}
+	if err := store.Save(profile, app.ClientID, token); err != nil {
+		warnf(log, "could not save token: %v", err)
+	}
+	return token, nil
+}
+
+// knownScope returns s without unknown bits. For example, tokens from
+// EnvStore have all bits set.
+func knownScope(s vk.Scope) vk.Scope {
+	known, _ := vk.ParseScope(strings.Join(s.Names(), ","))
+	return known
+}
+
+func warnf(w io.Writer, f string, args ...interface{}) {
+	if w != nil {
+		fmt.Fprintf(w, f+"\n", args...)
+	}
+}
+
+func checkScope(ctx context.Context, app vk.App, token *vk.AccessToken) error {
+	granted, err := clientOf(app).AppPermissions(ctx, token)
+	if err != nil {
+		return err
+	}
+	// Actual permissions may differ from requested ones.
+	token.Scope = granted
+	if !granted.Has(app.Scope) {
+		return &vk.ScopeError{
+			Missing: app.Scope &^ granted,
+		}
+	}
+	return nil
+}
+
+func clientOf(app vk.App) *vk.Client {
+	if app.Client != nil {
+		return app.Client
+	}
+	return vk.DefaultClient
+}
@@ -0,0 +1,193 @@
+package vk
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"golang.org/x/time/rate"
+
+	"github.com/gobwas/vk/internal/httputil"
+)
+
+const (
+	DefaultBaseURL  = "https://api.vk.com/method"
+	DefaultOAuthURL = "https://oauth.vk.com"
+	DefaultVersion  = version
+)
+
+// DefaultMaxURLLength is a maximum length of request url which is sent with
+// GET method when PostAuto policy is used.
+const DefaultMaxURLLength = 2048
+
+// PostPolicy describes when API requests are sent with POST method and
+// parameters encoded as application/x-www-form-urlencoded body.
+type PostPolicy int
+
+const (
+	// PostAuto sends request with POST method only when request url exceeds
+	// the Client's MaxURLLength.
+	PostAuto PostPolicy = iota
+	// PostNever always sends request with GET method.
+	PostNever
+	// PostAlways always sends request with POST method.
+	PostAlways
+)
+
+// DefaultClient is the Client used by Request and by Caller, Iterator and App
+// values which have no Client set.
+var DefaultClient = &Client{}
+
+// Client holds settings of the API calls. Zero value is ready to use and
+// makes calls to the api.vk.com with http.DefaultClient.
+//
+// Client must not be changed after first use.
+type Client struct {
+	// HTTPClient is used to send http requests. If nil, http.DefaultClient is
+	// used.
+	HTTPClient *http.Client
+
+	// BaseURL is an url of API methods. If empty, DefaultBaseURL is used.
+	BaseURL string
+
+	// OAuthURL is an url of authorization server. If empty, DefaultOAuthURL
+	// is used.
+	OAuthURL string
+
+	// Version is an API version sent with every request. If empty,
+	// DefaultVersion is used.
+	Version string
+
+	// AccessToken is a token sent with every request unless request options
+	// contain other one.
+	AccessToken *AccessToken
+
+	// Lang is a language of API responses. If empty, lang parameter is not
+	// sent.
+	Lang string
+
+	// Limiter is used by Caller and Iterator which have no own Limiter.
+	Limiter *rate.Limiter
+
+	// Post defines when requests are sent with POST method.
+	Post PostPolicy
+
+	// MaxURLLength is a maximum length of request url sent with GET method
+	// under PostAuto policy. If zero, DefaultMaxURLLength is used.
+	MaxURLLength int
+
+	// Interceptors wrap every call made with Client. First interceptor is
+	// the outermost one.
+	Interceptors []Interceptor
+}
+
+// Request makes API call of given method with given options and returns raw
+// response body.
+func (c *Client) Request(ctx context.Context, method string, options ...QueryOption) ([]byte, error) {
+	call := &Call{
+		Method: method,
+		Params: make(url.Values),
+	}
+	call.Params.Set("v", c.version())
+	if lang := c.Lang; lang != "" {
+		call.Params.Set("lang", lang)
+	}
+	if access := c.AccessToken; access != nil {
+		WithAccessToken(access)(call.Params)
+	}
+
+	WithOptions(options)(call.Params)
+
+	next := c.do
+	for i := len(c.Interceptors) - 1; i >= 0; i-- {
+		next = chain(c.Interceptors[i], next)
+	}
+	return next(ctx, call)
+}
+
+func (c *Client) do(ctx context.Context, call *Call) ([]byte, error) {
+	r, err := c.newHTTPRequest(call)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.httpClient().Do(r.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if err := httputil.CheckResponseStatus(resp); err != nil {
+		return nil, err
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+func (c *Client) newHTTPRequest(call *Call) (*http.Request, error) {
+	u, err := call.url(c.baseURL())
+	if err != nil {
+		return nil, err
+	}
+	if !c.post(u) {
+		return http.NewRequest("GET", u.String(), nil)
+	}
+	body := u.RawQuery
+	u.RawQuery = ""
+
+	r, err := http.NewRequest("POST", u.String(), strings.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return r, nil
+}
+
+func (c *Client) post(u *url.URL) bool {
+	switch c.Post {
+	case PostNever:
+		return false
+	case PostAlways:
+		return true
+	}
+	max := c.MaxURLLength
+	if max == 0 {
+		max = DefaultMaxURLLength
+	}
+	return len(u.String()) > max
+}
+
+func (c *Client) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+func (c *Client) baseURL() string {
+	if c.BaseURL != "" {
+		return c.BaseURL
+	}
+	return DefaultBaseURL
+}
+
+func (c *Client) oauthURL() string {
+	if c.OAuthURL != "" {
+		return c.OAuthURL
+	}
+	return DefaultOAuthURL
+}
+
+func (c *Client) version() string {
+	if c.Version != "" {
+		return c.Version
+	}
+	return DefaultVersion
+}
+
+func clientOrDefault(c *Client) *Client {
+	if c != nil {
+		return c
+	}
+	return DefaultClient
+}
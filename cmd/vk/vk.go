@@ -6,10 +6,13 @@ import (
 
 	"github.com/gobwas/vk/command/fave"
 	"github.com/gobwas/vk/command/friends"
+	"github.com/gobwas/vk/command/login"
+	"github.com/gobwas/vk/command/logout"
 	"github.com/gobwas/vk/command/messages"
 	"github.com/gobwas/vk/command/photos"
 	"github.com/gobwas/vk/command/posts"
 	"github.com/gobwas/vk/command/stub"
+	"github.com/gobwas/vk/command/whoami"
 	"github.com/mitchellh/cli"
 )
 
@@ -33,6 +36,9 @@ func main() {
 		"friends":  friends.CLI(&ui),
 		"messages": messages.CLI(&ui),
 		"fave":     fave.CLI(&ui),
+		"login":    login.CLI(&ui),
+		"logout":   logout.CLI(&ui),
+		"whoami":   whoami.CLI(&ui),
 	}
 
 	exitStatus, err := c.Run()
@@ -28,8 +28,11 @@ func CLI(ui cli.Ui) cli.CommandFactory {
 type Config struct {
 	ClientID       string
 	ClientSecret   string
+	Profile        string
+	TokenStore     vkcli.StoreConfig
 	DeleteInterval time.Duration
 	Token          string
+	Captcha        vkcli.CaptchaConfig
 }
 
 func (c *Config) ExportTo(flag *flag.FlagSet) {
@@ -41,6 +44,11 @@ func (c *Config) ExportTo(flag *flag.FlagSet) {
 		"client_secret", "",
 		"application secret",
 	)
+	flag.StringVar(&c.Profile,
+		"profile", vkcli.DefaultProfile,
+		"name of stored token profile",
+	)
+	c.TokenStore.ExportTo(flag)
 	flag.DurationVar(&c.DeleteInterval,
 		"interval", 3*time.Second,
 		"interval between deletions",
@@ -49,6 +57,7 @@ func (c *Config) ExportTo(flag *flag.FlagSet) {
 		"token", "",
 		"token retreived before",
 	)
+	c.Captcha.ExportTo(flag)
 }
 
 type Command struct {
@@ -63,6 +72,7 @@ func New(ui cli.Ui) *Command {
 
 	c := new(Config)
 	c.ExportTo(flag)
+	c.TokenStore.Log = os.Stderr
 
 	return &Command{
 		ui:     ui,
@@ -84,6 +94,15 @@ func (c *Command) Run(args []string) int {
 		return 1
 	}
 
+	solver, err := c.config.Captcha.CaptchaSolver()
+	if err != nil {
+		c.errorf("captcha solver error: %v", err)
+		return cli.RunResultHelp
+	}
+	// Captcha is shared by fetching and deleting calls, thus user is asked
+	// once when they hit captcha at the same time.
+	cp := &captcha{solver: solver}
+
 	lim := vk.DefaultLimiter()
 	limDelete := rate.NewLimiter(
 		rate.Every(c.config.DeleteInterval),
@@ -94,17 +113,17 @@ func (c *Command) Run(args []string) int {
 	photos := make(chan vk.Photo, 10)
 	videos := make(chan vk.Video, 10)
 	go func() {
-		if err := getPosts(ctx, access, lim, posts); err != nil {
+		if err := getPosts(ctx, access, lim, cp, posts); err != nil {
 			log.Fatal(err)
 		}
 		close(posts)
 
-		if err := getPhotos(ctx, access, lim, photos); err != nil {
+		if err := getPhotos(ctx, access, lim, cp, photos); err != nil {
 			log.Fatal(err)
 		}
 		close(photos)
 
-		if err := getVideos(ctx, access, lim, videos); err != nil {
+		if err := getVideos(ctx, access, lim, cp, videos); err != nil {
 			log.Fatal(err)
 		}
 		close(videos)
@@ -112,7 +131,7 @@ func (c *Command) Run(args []string) int {
 
 	var n int64
 	for post := range posts {
-		if err := deleteLike(ctx, access, limDelete, post); err != nil {
+		if err := deleteLike(ctx, access, limDelete, cp, post); err != nil {
 			log.Fatal(err)
 		}
 		log.Println("unliked post", homePage(post))
@@ -122,7 +141,7 @@ func (c *Command) Run(args []string) int {
 
 	n = 0
 	for photo := range photos {
-		if err := deleteLike(ctx, access, limDelete, photo); err != nil {
+		if err := deleteLike(ctx, access, limDelete, cp, photo); err != nil {
 			log.Fatal(err)
 		}
 		log.Println("unliked photo", download.GetLargestSize(photo.Sizes).Src)
@@ -132,7 +151,7 @@ func (c *Command) Run(args []string) int {
 
 	n = 0
 	for video := range videos {
-		if err := deleteLike(ctx, access, limDelete, video); err != nil {
+		if err := deleteLike(ctx, access, limDelete, cp, video); err != nil {
 			log.Fatal(err)
 		}
 		log.Printf("unliked video %s %s", video.Player, video.Photo800)
@@ -152,14 +171,19 @@ func (c *Command) Authorize(ctx context.Context) (*vk.AccessToken, error) {
 		ClientSecret: c.config.ClientSecret,
 		Scope:        vk.ScopeWall | vk.ScopeFriends | vk.ScopeOffline,
 	}
-	return vkcli.AuthorizeStandalone(ctx, app)
+	return c.config.TokenStore.Login(ctx, c.config.Profile, app)
+}
+
+type captcha struct {
+	solver vkcli.CaptchaSolver
+	gate   vk.CaptchaGate
 }
 
 func homePage(post vk.Post) string {
 	return "https://vk.com/wall" + strconv.Itoa(post.OwnerID) + "_" + strconv.Itoa(post.ID)
 }
 
-func deleteLike(ctx context.Context, access *vk.AccessToken, lim *rate.Limiter, v interface{}) error {
+func deleteLike(ctx context.Context, access *vk.AccessToken, lim *rate.Limiter, cp *captcha, v interface{}) error {
 	var (
 		t       string
 		id      int
@@ -191,39 +215,34 @@ func deleteLike(ctx context.Context, access *vk.AccessToken, lim *rate.Limiter,
 			vk.WithNumber("owner_id", ownerID),
 		),
 		Limiter:        lim,
-		ResolveCaptcha: vkcli.ResolveCaptcha,
+		ResolveCaptcha: cp.solver.SolveCaptcha,
+		CaptchaGate:    &cp.gate,
 	}
 
 	_, err := c.Call(ctx)
 	return err
 }
 
-func getVideos(ctx context.Context, access *vk.AccessToken, lim *rate.Limiter, videos chan<- vk.Video) error {
-	var list vk.Videos
-	it := vk.Iterator{
+func getVideos(ctx context.Context, access *vk.AccessToken, lim *rate.Limiter, cp *captcha, videos chan<- vk.Video) error {
+	p := vk.NewPager[vk.Video](&vk.Iterator{
 		Method:  "fave.getVideos",
 		Limiter: lim,
 		Options: vk.QueryOptions(
 			vk.WithAccessToken(access),
 			vk.WithNumber("count", 50),
 		),
-		Parse: func(p []byte) (int, error) {
-			list = vk.Videos{}
-			err := list.UnmarshalJSON(p)
-			return len(list.Items), err
-		},
-	}
-	for it.Next(ctx) {
-		for _, video := range list.Items {
-			videos <- video
-		}
+
+		ResolveCaptcha: cp.solver.SolveCaptcha,
+		CaptchaGate:    &cp.gate,
+	})
+	for video := range p.All(ctx) {
+		videos <- video
 	}
-	return it.Err()
+	return p.Err()
 }
 
-func getPhotos(ctx context.Context, access *vk.AccessToken, lim *rate.Limiter, photos chan<- vk.Photo) error {
-	var list vk.Photos
-	it := vk.Iterator{
+func getPhotos(ctx context.Context, access *vk.AccessToken, lim *rate.Limiter, cp *captcha, photos chan<- vk.Photo) error {
+	p := vk.NewPager[vk.Photo](&vk.Iterator{
 		Method:  "fave.getPhotos",
 		Limiter: lim,
 		Options: vk.QueryOptions(
@@ -231,41 +250,32 @@ func getPhotos(ctx context.Context, access *vk.AccessToken, lim *rate.Limiter, p
 			vk.WithNumber("count", 50),
 			vk.WithNumber("photo_sizes", 1),
 		),
-		Parse: func(p []byte) (int, error) {
-			list = vk.Photos{}
-			err := list.UnmarshalJSON(p)
-			return len(list.Items), err
-		},
-	}
-	for it.Next(ctx) {
-		for _, photo := range list.Items {
-			photos <- photo
-		}
+
+		ResolveCaptcha: cp.solver.SolveCaptcha,
+		CaptchaGate:    &cp.gate,
+	})
+	for photo := range p.All(ctx) {
+		photos <- photo
 	}
-	return it.Err()
+	return p.Err()
 }
 
-func getPosts(ctx context.Context, access *vk.AccessToken, lim *rate.Limiter, posts chan<- vk.Post) error {
-	var list vk.Posts
-	it := vk.Iterator{
+func getPosts(ctx context.Context, access *vk.AccessToken, lim *rate.Limiter, cp *captcha, posts chan<- vk.Post) error {
+	p := vk.NewPager[vk.Post](&vk.Iterator{
 		Method:  "fave.getPosts",
 		Limiter: lim,
 		Options: vk.QueryOptions(
 			vk.WithAccessToken(access),
 			vk.WithNumber("count", 100),
 		),
-		Parse: func(p []byte) (int, error) {
-			list = vk.Posts{}
-			err := list.UnmarshalJSON(p)
-			return len(list.Items), err
-		},
-	}
-	for it.Next(ctx) {
-		for _, post := range list.Items {
-			posts <- post
-		}
+
+		ResolveCaptcha: cp.solver.SolveCaptcha,
+		CaptchaGate:    &cp.gate,
+	})
+	for post := range p.All(ctx) {
+		posts <- post
 	}
-	return it.Err()
+	return p.Err()
 }
 
 func (c *Command) errorf(f string, args ...interface{}) {
@@ -26,6 +26,8 @@ func CLI(ui cli.Ui) cli.CommandFactory {
 type Config struct {
 	ClientID     string
 	ClientSecret string
+	Profile      string
+	TokenStore   vkcli.StoreConfig
 	Force        bool
 }
 
@@ -38,6 +40,11 @@ func (c *Config) ExportTo(flag *flag.FlagSet) {
 		"client_secret", "",
 		"application secret",
 	)
+	flag.StringVar(&c.Profile,
+		"profile", vkcli.DefaultProfile,
+		"name of stored token profile",
+	)
+	c.TokenStore.ExportTo(flag)
 	flag.BoolVar(&c.Force,
 		"force", false,
 		"delete friends without prompt",
@@ -56,6 +63,7 @@ func New(ui cli.Ui) *Command {
 
 	c := new(Config)
 	c.ExportTo(flag)
+	c.TokenStore.Log = os.Stderr
 
 	return &Command{
 		ui:     ui,
@@ -76,7 +84,7 @@ func (c *Command) Run(args []string) int {
 		ClientSecret: c.config.ClientSecret,
 		Scope:        vk.ScopeFriends,
 	}
-	access, err := vkcli.AuthorizeStandalone(ctx, app)
+	access, err := c.config.TokenStore.Login(ctx, c.config.Profile, app)
 	if err != nil {
 		c.errorf("authorize error: %v", err)
 		return 1
@@ -144,20 +152,15 @@ func homePage(f vk.User) string {
 }
 
 func deleteFriend(ctx context.Context, access *vk.AccessToken, lim *rate.Limiter, friend vk.User) error {
-retry:
-	if err := lim.Wait(ctx); err != nil {
-		return err
-	}
-	bts, err := vk.Request(ctx, "friends.delete",
-		vk.WithAccessToken(access),
-		vk.WithParam("user_id", strconv.Itoa(friend.ID)),
-	)
-	if err == nil {
-		_, err = vk.StripResponse(bts)
-	}
-	if vk.TemporaryError(err) {
-		goto retry
+	c := vk.Caller{
+		Method: "friends.delete",
+		Options: vk.QueryOptions(
+			vk.WithAccessToken(access),
+			vk.WithParam("user_id", strconv.Itoa(friend.ID)),
+		),
+		Limiter: lim,
 	}
+	_, err := c.Call(ctx)
 	return err
 }
 
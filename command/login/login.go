@@ -0,0 +1,209 @@
+package login
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/gobwas/vk"
+	vkcli "github.com/gobwas/vk/cli"
+	"github.com/mitchellh/cli"
+)
+
+// EnvPassword is an environment variable holding password used with
+// -username flag.
+const EnvPassword = "VK_PASSWORD"
+
+// DefaultScope is a scope required by all of the vk commands.
+const DefaultScope = "friends,photos,messages,wall,offline"
+
+func CLI(ui cli.Ui) cli.CommandFactory {
+	return func() (cli.Command, error) {
+		return New(ui), nil
+	}
+}
+
+type Config struct {
+	ClientID     string
+	ClientSecret string
+	Profile      string
+	TokenStore   vkcli.StoreConfig
+	Scope        string
+	Username     string
+	PasswordFile string
+	Code         string
+	AskCode      bool
+	Captcha      vkcli.CaptchaConfig
+}
+
+func (c *Config) ExportTo(flag *flag.FlagSet) {
+	flag.StringVar(&c.ClientID,
+		"client_id", "",
+		"application id",
+	)
+	flag.StringVar(&c.ClientSecret,
+		"client_secret", "",
+		"application secret",
+	)
+	flag.StringVar(&c.Profile,
+		"profile", vkcli.DefaultProfile,
+		"name of stored token profile",
+	)
+	c.TokenStore.ExportTo(flag)
+	flag.StringVar(&c.Scope,
+		"scope", DefaultScope,
+		"comma separated access permissions",
+	)
+	flag.StringVar(&c.Username,
+		"username", "",
+		"authorize directly by username and password (trusted apps only);\n"+
+			"password is read from "+EnvPassword+" or -password_file",
+	)
+	flag.StringVar(&c.PasswordFile,
+		"password_file", "",
+		"path to file containing password for direct authorization",
+	)
+	flag.StringVar(&c.Code,
+		"code", "",
+		"two-factor authentication code for direct authorization",
+	)
+	flag.BoolVar(&c.AskCode,
+		"ask_code", vkcli.Interactive(),
+		"ask for two-factor authentication code if -code is not set or rejected",
+	)
+	c.Captcha.ExportTo(flag)
+}
+
+type Command struct {
+	ui     cli.Ui
+	flag   *flag.FlagSet
+	config *Config
+}
+
+func New(ui cli.Ui) *Command {
+	flag := flag.NewFlagSet("", flag.ContinueOnError)
+	flag.Usage = func() {}
+
+	c := new(Config)
+	c.ExportTo(flag)
+
+	return &Command{
+		ui:     ui,
+		flag:   flag,
+		config: c,
+	}
+}
+
+func (c *Command) Run(args []string) int {
+	if err := c.flag.Parse(args); err != nil {
+		return cli.RunResultHelp
+	}
+
+	ctx := context.Background()
+
+	scope, err := vk.ParseScope(c.config.Scope)
+	if err != nil {
+		c.errorf("parse scope error: %v", err)
+		return cli.RunResultHelp
+	}
+	app := vk.App{
+		ClientID:     c.config.ClientID,
+		ClientSecret: c.config.ClientSecret,
+		Scope:        scope,
+	}
+	store, err := c.config.TokenStore.Open(ctx)
+	if err != nil {
+		c.errorf("open token store error: %v", err)
+		return 1
+	}
+	// Always authorize to let user switch accounts.
+	access, err := c.authorize(ctx, app)
+	if err != nil {
+		c.errorf("authorize error: %v", err)
+		return 1
+	}
+	granted, err := vk.AppPermissions(ctx, access)
+	if err != nil {
+		c.errorf("get permissions error: %v", err)
+		return 1
+	}
+	access.Scope = granted
+	if missing := scope &^ granted; missing != 0 {
+		c.ui.Warn(fmt.Sprintf(
+			"permissions were not granted: %s",
+			strings.Join(missing.Names(), ", "),
+		))
+	}
+	err = store.Save(c.config.Profile, app.ClientID, access)
+	if err != nil {
+		c.errorf("save token error: %v", err)
+		return 1
+	}
+
+	c.ui.Output(fmt.Sprintf(
+		"logged in as user %d (profile %q)",
+		access.UserID, c.config.Profile,
+	))
+
+	return 0
+}
+
+func (c *Command) authorize(ctx context.Context, app vk.App) (*vk.AccessToken, error) {
+	if c.config.Username == "" {
+		return vkcli.AuthorizeStandalone(ctx, app)
+	}
+	password, err := c.password(ctx)
+	if err != nil {
+		return nil, err
+	}
+	captcha, err := c.config.Captcha.CaptchaSolver()
+	if err != nil {
+		return nil, err
+	}
+	return vkcli.AuthorizeDirect(ctx, app, c.config.Username, password, vkcli.DirectOptions{
+		Code:    c.config.Code,
+		AskCode: c.config.AskCode,
+		Captcha: captcha,
+	})
+}
+
+func (c *Command) password(ctx context.Context) (string, error) {
+	if p := os.Getenv(EnvPassword); p != "" {
+		return p, nil
+	}
+	if c.config.PasswordFile != "" {
+		bts, err := ioutil.ReadFile(c.config.PasswordFile)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimRight(string(bts), "\r\n"), nil
+	}
+	return vkcli.AskPassword(ctx, "password: ")
+}
+
+func (c *Command) errorf(f string, args ...interface{}) {
+	c.ui.Error(fmt.Sprintf(f, args...))
+}
+
+func (c *Command) flagDefaults() string {
+	var buf bytes.Buffer
+	c.flag.SetOutput(&buf)
+	c.flag.PrintDefaults()
+	c.flag.SetOutput(os.Stderr)
+	return buf.String()
+}
+
+func (c *Command) Synopsis() string {
+	return "authorize and store access token"
+}
+
+func (c *Command) Help() string {
+	return strings.Join([]string{
+		"Usage: login [options]",
+		c.flagDefaults(),
+	}, "\n")
+}
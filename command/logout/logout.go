@@ -0,0 +1,110 @@
+package logout
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	vkcli "github.com/gobwas/vk/cli"
+	"github.com/mitchellh/cli"
+)
+
+func CLI(ui cli.Ui) cli.CommandFactory {
+	return func() (cli.Command, error) {
+		return New(ui), nil
+	}
+}
+
+type Config struct {
+	ClientID   string
+	Profile    string
+	TokenStore vkcli.StoreConfig
+}
+
+func (c *Config) ExportTo(flag *flag.FlagSet) {
+	flag.StringVar(&c.ClientID,
+		"client_id", "",
+		"application id (empty for all applications)",
+	)
+	flag.StringVar(&c.Profile,
+		"profile", vkcli.DefaultProfile,
+		"name of stored token profile",
+	)
+	c.TokenStore.ExportTo(flag)
+}
+
+type Command struct {
+	ui     cli.Ui
+	flag   *flag.FlagSet
+	config *Config
+}
+
+func New(ui cli.Ui) *Command {
+	flag := flag.NewFlagSet("", flag.ContinueOnError)
+	flag.Usage = func() {}
+
+	c := new(Config)
+	c.ExportTo(flag)
+
+	return &Command{
+		ui:     ui,
+		flag:   flag,
+		config: c,
+	}
+}
+
+func (c *Command) Run(args []string) int {
+	if err := c.flag.Parse(args); err != nil {
+		return cli.RunResultHelp
+	}
+
+	ctx := context.Background()
+
+	store, err := c.config.TokenStore.Open(ctx)
+	if err != nil {
+		c.errorf("open token store error: %v", err)
+		return 1
+	}
+	n, err := store.Delete(c.config.Profile, c.config.ClientID)
+	if err != nil {
+		c.errorf("delete token error: %v", err)
+		return 1
+	}
+	if n == 0 {
+		c.ui.Output(fmt.Sprintf("no tokens stored for profile %q", c.config.Profile))
+		return 0
+	}
+	if n == vkcli.DeletedUnknown {
+		c.ui.Output(fmt.Sprintf("erased tokens of profile %q", c.config.Profile))
+		return 0
+	}
+	c.ui.Output(fmt.Sprintf("removed %d token(s) of profile %q", n, c.config.Profile))
+
+	return 0
+}
+
+func (c *Command) errorf(f string, args ...interface{}) {
+	c.ui.Error(fmt.Sprintf(f, args...))
+}
+
+func (c *Command) flagDefaults() string {
+	var buf bytes.Buffer
+	c.flag.SetOutput(&buf)
+	c.flag.PrintDefaults()
+	c.flag.SetOutput(os.Stderr)
+	return buf.String()
+}
+
+func (c *Command) Synopsis() string {
+	return "remove stored access tokens"
+}
+
+func (c *Command) Help() string {
+	return strings.Join([]string{
+		"Usage: logout [options]",
+		c.flagDefaults(),
+	}, "\n")
+}
@@ -14,8 +14,7 @@ var t *template.Template
 func init() {
 	t = template.New("index.html")
 	t.Funcs(template.FuncMap(map[string]interface{}{
-		"toDate": func(unix int64) string {
-			t := time.Unix(unix, 0)
+		"toDate": func(t vk.UnixTime) string {
 			return strings.Replace(t.Format(time.RFC3339), "T", " ", 1)
 		},
 		"homePage": func(user vk.User) string {
@@ -35,6 +35,8 @@ func CLI(ui cli.Ui) cli.CommandFactory {
 type Config struct {
 	ClientID     string
 	ClientSecret string
+	Profile      string
+	TokenStore   vkcli.StoreConfig
 	Dest         string
 	All          bool
 	Parallelism  int
@@ -52,6 +54,11 @@ func (c *Config) ExportTo(flag *flag.FlagSet) {
 		"client_secret", "",
 		"application secret",
 	)
+	flag.StringVar(&c.Profile,
+		"profile", vkcli.DefaultProfile,
+		"name of stored token profile",
+	)
+	c.TokenStore.ExportTo(flag)
 	flag.StringVar(&c.Dest,
 		"dest", download.GetDefaultDest("messages"),
 		"destination root dir for saved chats",
@@ -90,6 +97,7 @@ func New(ui cli.Ui) *Command {
 
 	c := new(Config)
 	c.ExportTo(flag)
+	c.TokenStore.Log = os.Stderr
 
 	return &Command{
 		ui:     ui,
@@ -117,7 +125,7 @@ func (c *Command) Run(args []string) int {
 			ClientSecret: c.config.ClientSecret,
 			Scope:        vk.ScopeMessages,
 		}
-		access, err = vkcli.AuthorizeStandalone(ctx, app)
+		access, err = c.config.TokenStore.Login(ctx, c.config.Profile, app)
 	}
 	if err != nil {
 		c.errorf("authorize error: %v", err)
@@ -302,21 +310,15 @@ func (c *Command) deleteChat(ctx context.Context, access *vk.AccessToken, lim *r
 		for _, message := range list.Items {
 			ids = append(ids, message.ID)
 		}
-	retry:
-		if err := lim.Wait(ctx); err != nil {
-			return err
-		}
-		bts, err := vk.Request(ctx, "messages.delete",
-			vk.WithAccessToken(access),
-			vk.WithNumbers("message_ids", ids...),
-		)
-		if err == nil {
-			_, err = vk.StripResponse(bts)
-		}
-		if vk.TemporaryError(err) {
-			goto retry
+		del := vk.Caller{
+			Method: "messages.delete",
+			Options: vk.QueryOptions(
+				vk.WithAccessToken(access),
+				vk.WithNumbers("message_ids", ids...),
+			),
+			Limiter: lim,
 		}
-		if err != nil {
+		if _, err := del.Call(ctx); err != nil {
 			return err
 		}
 	}
@@ -324,21 +326,16 @@ func (c *Command) deleteChat(ctx context.Context, access *vk.AccessToken, lim *r
 		return err
 	}
 
-retryd:
-	if err := lim.Wait(ctx); err != nil {
-		return err
-	}
-	bts, err := vk.Request(ctx, "messages.deleteDialog",
-		vk.WithAccessToken(access),
-		vk.WithNumber("user_id", user.ID),
-		vk.WithNumber("count", 10000),
-	)
-	if err == nil {
-		_, err = vk.StripResponse(bts)
-	}
-	if vk.TemporaryError(err) {
-		goto retryd
+	del := vk.Caller{
+		Method: "messages.deleteDialog",
+		Options: vk.QueryOptions(
+			vk.WithAccessToken(access),
+			vk.WithNumber("user_id", user.ID),
+			vk.WithNumber("count", 10000),
+		),
+		Limiter: lim,
 	}
+	_, err := del.Call(ctx)
 	return err
 }
 
@@ -427,16 +424,12 @@ func (c *Command) saveChat(ctx context.Context, access *vk.AccessToken, lim *rat
 
 		for _, message := range list.Items {
 			messages <- message
-			for _, attach := range message.Attachments {
-				if attach.Type != "photo" {
-					continue
-				}
-				size := download.GetLargestSize(attach.Photo.Sizes)
-				err := download.Photo(ctx, userDir, attach.Photo, size)
+			for _, media := range message.Media() {
+				err := download.Media(ctx, userDir, media)
 				if err != nil {
 					log.Printf(
-						"download %s %s (%s) attachement photo %s error: %v",
-						user.FirstName, user.LastName, user.Domain, size.Src, err,
+						"download %s %s (%s) attachment %s %s error: %v",
+						user.FirstName, user.LastName, user.Domain, media.Type, media.URL, err,
 					)
 				}
 			}
@@ -473,63 +466,22 @@ func (c *Command) Help() string {
 }
 
 func getUsers(ctx context.Context, access *vk.AccessToken, lim *rate.Limiter, userIDs []int) ([]vk.User, error) {
-	users := make([]vk.User, 0, len(userIDs))
-
-	for i := 0; i < len(userIDs); i += 1000 {
-		sub := userIDs[i:]
-		if len(sub) > 1000 {
-			sub = sub[:1000]
-		}
-		if err := lim.Wait(ctx); err != nil {
-			return users, err
-		}
-		bts, err := vk.Request(ctx, "users.get",
-			vk.WithAccessToken(access),
-			vk.WithNumbers("user_ids", sub...),
-			vk.WithStrings("fields", "domain"),
-		)
-		if err == nil {
-			bts, err = vk.StripResponse(bts)
-		}
-		if err != nil {
-			return nil, err
-		}
-
-		// Need to hack up response.
-		bts = append([]byte(`{"items":`), bts...)
-		bts = append(bts, '}')
-
-		var list vk.Users
-		if err = list.UnmarshalJSON(bts); err != nil {
-			return nil, err
-		}
-		users = append(users, list.Items...)
+	users := vk.UsersService{
+		Token:   access,
+		Limiter: lim,
 	}
-
-	return users, nil
+	return users.Get(ctx, userIDs, vk.UserFieldDomain)
 }
 
 func getDialogs(ctx context.Context, access *vk.AccessToken, lim *rate.Limiter) (ret []vk.Dialog, err error) {
-	var list vk.Dialogs
-	it := vk.Iterator{
+	return vk.NewPager[vk.Dialog](&vk.Iterator{
 		Method: "messages.getDialogs",
 		Options: vk.QueryOptions(
 			vk.WithAccessToken(access),
 			vk.WithNumber("count", 200),
 		),
-		Parse: func(p []byte) (int, error) {
-			list = vk.Dialogs{} // Reset.
-			err := list.UnmarshalJSON(p)
-			return len(list.Items), err
-		},
 		Limiter: lim,
-	}
-	for it.Next(ctx) {
-		for _, d := range list.Items {
-			ret = append(ret, d)
-		}
-	}
-	return ret, it.Err()
+	}).Collect(ctx)
 }
 
 func appendUserDir(dest string, user vk.User) string {
@@ -32,9 +32,12 @@ func CLI(ui cli.Ui) cli.CommandFactory {
 type Config struct {
 	ClientID     string
 	ClientSecret string
+	Profile      string
+	TokenStore   vkcli.StoreConfig
 	Dest         string
 	OwnerID      int
 	Parallelism  int
+	Prefetch     int
 	Delete       bool
 }
 
@@ -47,6 +50,11 @@ func (c *Config) ExportTo(flag *flag.FlagSet) {
 		"client_secret", "",
 		"application secret",
 	)
+	flag.StringVar(&c.Profile,
+		"profile", vkcli.DefaultProfile,
+		"name of stored token profile",
+	)
+	c.TokenStore.ExportTo(flag)
 	flag.IntVar(&c.OwnerID,
 		"owner_id", 0,
 		"albums owner id (empty for your id)",
@@ -59,6 +67,10 @@ func (c *Config) ExportTo(flag *flag.FlagSet) {
 		"parallelism", 64,
 		"number of parallel downloads",
 	)
+	flag.IntVar(&c.Prefetch,
+		"prefetch", 4,
+		"number of album pages fetched in parallel",
+	)
 	flag.BoolVar(&c.Delete,
 		"delete", false,
 		"just delete photos without store",
@@ -77,6 +89,7 @@ func New(ui cli.Ui) *Command {
 
 	c := new(Config)
 	c.ExportTo(flag)
+	c.TokenStore.Log = os.Stderr
 
 	return &Command{
 		ui:     ui,
@@ -97,7 +110,7 @@ func (c *Command) Run(args []string) int {
 		ClientSecret: c.config.ClientSecret,
 		Scope:        vk.ScopePhotos | vk.ScopeWall | vk.ScopeFriends,
 	}
-	access, err := vkcli.AuthorizeStandalone(ctx, app)
+	access, err := c.config.TokenStore.Login(ctx, c.config.Profile, app)
 	if err != nil {
 		c.errorf("authorize error: %v", err)
 		return 1
@@ -146,17 +159,20 @@ func (c *Command) Run(args []string) int {
 		mpb.OutputInterceptors(ringLogger.Interceptor()),
 	)
 
-	limit := rate.NewLimiter(
-		rate.Every(vk.DefaultRateInterval),
-		vk.DefaultRateBurst,
-	)
+	// Batch deletion calls into execute requests.
+	batch := &vk.Batcher{
+		Limiter: rate.NewLimiter(
+			rate.Every(vk.DefaultRateInterval),
+			vk.DefaultRateBurst,
+		),
+	}
 
 	var wg sync.WaitGroup
 	work := make(chan PhotoFromAlbum, 100)
 	for i := 0; i < c.config.Parallelism; i++ {
 		wg.Add(1)
 		if c.config.Delete {
-			go deletePhotoFromAlbum(ctx, access, limit, &wg, &bars, work)
+			go deletePhotoFromAlbum(ctx, access, batch, &wg, &bars, work)
 		} else {
 			go downloadPhotoFromAlbum(ctx, &wg, &bars, dest, work)
 		}
@@ -171,7 +187,7 @@ func (c *Command) Run(args []string) int {
 		case -5:
 			photos, err = getUserFavePhotos(ctx, access, ownerID)
 		default:
-			photos, err = getAlbumPhotos(ctx, access, ownerID, album.ID)
+			photos, err = getAlbumPhotos(ctx, access, ownerID, album.ID, c.config.Prefetch)
 		}
 
 		if err != nil {
@@ -217,7 +233,7 @@ func (c *Command) Run(args []string) int {
 	progress.Stop()
 
 	if c.config.Delete {
-		deleteAlbums(ctx, access, limit, albums)
+		deleteAlbums(ctx, access, batch, albums)
 	}
 
 	return 0
@@ -288,37 +304,34 @@ type PhotoFromAlbum struct {
 	Album vk.PhotoAlbum
 }
 
-func deleteAlbums(ctx context.Context, access *vk.AccessToken, lim *rate.Limiter, albums []vk.PhotoAlbum) error {
+func deleteAlbums(ctx context.Context, access *vk.AccessToken, batch *vk.Batcher, albums []vk.PhotoAlbum) error {
 	for _, album := range albums {
-	retry:
-		if err := lim.Wait(ctx); err != nil {
-			return err
-		}
-		bts, err := vk.Request(ctx, "photos.deleteAlbum",
-			vk.WithAccessToken(access),
-			vk.WithNumber("album_id", album.ID),
-		)
-		if err == nil {
-			_, err = vk.StripResponse(bts)
+		c := vk.Caller{
+			Method: "photos.deleteAlbum",
+			Options: vk.QueryOptions(
+				vk.WithAccessToken(access),
+				vk.WithNumber("album_id", album.ID),
+			),
+			Batcher: batch,
 		}
-		if vk.TemporaryError(err) {
-			goto retry
+		if _, err := c.Call(ctx); err != nil && ctx.Err() != nil {
+			return err
 		}
 	}
 	return nil
 }
 
-func deletePhotoFromAlbum(ctx context.Context, access *vk.AccessToken, lim *rate.Limiter, wg *sync.WaitGroup, bars *sync.Map, work <-chan PhotoFromAlbum) {
+func deletePhotoFromAlbum(ctx context.Context, access *vk.AccessToken, batch *vk.Batcher, wg *sync.WaitGroup, bars *sync.Map, work <-chan PhotoFromAlbum) {
 	defer wg.Done()
 	for pa := range work {
 		var err error
 		switch pa.Album.ID {
 		case -4:
-			err = removeTag(ctx, access, lim, pa.Photo)
+			err = removeTag(ctx, access, batch, pa.Photo)
 		case -5:
 			// Do nothing for fave.
 		default:
-			err = deletePhoto(ctx, access, lim, pa.Photo)
+			err = deletePhoto(ctx, access, batch, pa.Photo)
 		}
 		if err != nil {
 			log.Printf(
@@ -331,47 +344,40 @@ func deletePhotoFromAlbum(ctx context.Context, access *vk.AccessToken, lim *rate
 	}
 }
 
-func removeTag(ctx context.Context, access *vk.AccessToken, lim *rate.Limiter, photo vk.Photo) error {
-	tag, err := getTag(ctx, access, lim, photo)
+func removeTag(ctx context.Context, access *vk.AccessToken, batch *vk.Batcher, photo vk.Photo) error {
+	tag, err := getTag(ctx, access, batch, photo)
 	if err != nil {
 		return err
 	}
-	return deleteTag(ctx, access, lim, photo, tag)
+	return deleteTag(ctx, access, batch, photo, tag)
 }
 
-func deletePhoto(ctx context.Context, access *vk.AccessToken, lim *rate.Limiter, photo vk.Photo) error {
-retry:
-	if err := lim.Wait(ctx); err != nil {
-		return err
-	}
-	bts, err := vk.Request(ctx, "photos.delete",
-		vk.WithAccessToken(access),
-		vk.WithNumber("photo_id", photo.ID),
-	)
-	if err == nil {
-		_, err = vk.StripResponse(bts)
-	}
-	if vk.TemporaryError(err) {
-		goto retry
+func deletePhoto(ctx context.Context, access *vk.AccessToken, batch *vk.Batcher, photo vk.Photo) error {
+	c := vk.Caller{
+		Method: "photos.delete",
+		Options: vk.QueryOptions(
+			vk.WithAccessToken(access),
+			vk.WithNumber("photo_id", photo.ID),
+		),
+		Batcher: batch,
 	}
+	_, err := c.Call(ctx)
 	return err
 }
 
-func getTag(ctx context.Context, access *vk.AccessToken, lim *rate.Limiter, photo vk.Photo) (tag vk.Tag, err error) {
-retry:
-	if err := lim.Wait(ctx); err != nil {
-		return tag, err
-	}
-	bts, err := vk.Request(ctx, "photos.getTags",
-		vk.WithAccessToken(access),
-		vk.WithNumber("photo_id", photo.ID),
-		vk.WithNumber("owner_id", photo.OwnerID),
-	)
-	if err == nil {
-		bts, err = vk.StripResponse(bts)
+func getTag(ctx context.Context, access *vk.AccessToken, batch *vk.Batcher, photo vk.Photo) (tag vk.Tag, err error) {
+	c := vk.Caller{
+		Method: "photos.getTags",
+		Options: vk.QueryOptions(
+			vk.WithAccessToken(access),
+			vk.WithNumber("photo_id", photo.ID),
+			vk.WithNumber("owner_id", photo.OwnerID),
+		),
+		Batcher: batch,
 	}
-	if vk.TemporaryError(err) {
-		goto retry
+	bts, err := c.Call(ctx)
+	if err != nil {
+		return tag, err
 	}
 
 	// Need to hack up response.
@@ -389,23 +395,18 @@ retry:
 	return tag, err
 }
 
-func deleteTag(ctx context.Context, access *vk.AccessToken, lim *rate.Limiter, photo vk.Photo, tag vk.Tag) error {
-retry:
-	if err := lim.Wait(ctx); err != nil {
-		return err
-	}
-	bts, err := vk.Request(ctx, "photos.removeTag",
-		vk.WithAccessToken(access),
-		vk.WithNumber("owner_id", photo.OwnerID),
-		vk.WithNumber("photo_id", photo.ID),
-		vk.WithNumber("tag_id", tag.ID),
-	)
-	if err == nil {
-		_, err = vk.StripResponse(bts)
-	}
-	if vk.TemporaryError(err) {
-		goto retry
+func deleteTag(ctx context.Context, access *vk.AccessToken, batch *vk.Batcher, photo vk.Photo, tag vk.Tag) error {
+	c := vk.Caller{
+		Method: "photos.removeTag",
+		Options: vk.QueryOptions(
+			vk.WithAccessToken(access),
+			vk.WithNumber("owner_id", photo.OwnerID),
+			vk.WithNumber("photo_id", photo.ID),
+			vk.WithNumber("tag_id", tag.ID),
+		),
+		Batcher: batch,
 	}
+	_, err := c.Call(ctx)
 	return err
 }
 
@@ -452,8 +453,7 @@ func getAlbums(ctx context.Context, access *vk.AccessToken, ownerID int) (as []v
 }
 
 func getUserTaggedPhotos(ctx context.Context, access *vk.AccessToken, userID int) (ps []vk.Photo, err error) {
-	var list vk.Photos
-	it := vk.Iterator{
+	return vk.NewPager[vk.Photo](&vk.Iterator{
 		Method: "photos.getUserPhotos",
 		Options: vk.QueryOptions(
 			vk.WithAccessToken(access),
@@ -462,40 +462,21 @@ func getUserTaggedPhotos(ctx context.Context, access *vk.AccessToken, userID int
 			vk.WithNumber("photo_sizes", 1), // Special sizes format.
 			vk.WithNumber("count", 1000),
 		),
-		Parse: func(p []byte) (int, error) {
-			list = vk.Photos{} // Reset.
-			err := list.UnmarshalJSON(p)
-			return len(list.Items), err
-		},
-	}
-	for it.Next(ctx) {
-		ps = append(ps, list.Items...)
-	}
-	return ps, it.Err()
+	}).Collect(ctx)
 }
 
 func getUserFavePhotos(ctx context.Context, access *vk.AccessToken, userID int) (ps []vk.Photo, err error) {
-	var list vk.Photos
-	it := vk.Iterator{
+	return vk.NewPager[vk.Photo](&vk.Iterator{
 		Method: "fave.getPhotos",
 		Options: vk.QueryOptions(
 			vk.WithAccessToken(access),
 			vk.WithNumber("photo_sizes", 1), // Special sizes format.
 			vk.WithNumber("count", 50),
 		),
-		Parse: func(p []byte) (int, error) {
-			list = vk.Photos{} // Reset.
-			err := list.UnmarshalJSON(p)
-			return len(list.Items), err
-		},
-	}
-	for it.Next(ctx) {
-		ps = append(ps, list.Items...)
-	}
-	return ps, it.Err()
+	}).Collect(ctx)
 }
 
-func getAlbumPhotos(ctx context.Context, access *vk.AccessToken, ownerID, albumID int) (ps []vk.Photo, err error) {
+func getAlbumPhotos(ctx context.Context, access *vk.AccessToken, ownerID, albumID, prefetch int) (ps []vk.Photo, err error) {
 	var album string
 	switch albumID {
 	case -1:
@@ -507,8 +488,7 @@ func getAlbumPhotos(ctx context.Context, access *vk.AccessToken, ownerID, albumI
 	default:
 		album = strconv.Itoa(albumID)
 	}
-	var list vk.Photos
-	it := vk.Iterator{
+	return vk.NewPager[vk.Photo](&vk.Iterator{
 		Method: "photos.get",
 		Options: vk.QueryOptions(
 			vk.WithAccessToken(access),
@@ -518,14 +498,6 @@ func getAlbumPhotos(ctx context.Context, access *vk.AccessToken, ownerID, albumI
 			vk.WithNumber("photo_sizes", 1), // Special sizes format.
 			vk.WithNumber("count", 1000),
 		),
-		Parse: func(p []byte) (int, error) {
-			list = vk.Photos{} // Reset.
-			err := list.UnmarshalJSON(p)
-			return len(list.Items), err
-		},
-	}
-	for it.Next(ctx) {
-		ps = append(ps, list.Items...)
-	}
-	return ps, it.Err()
+		Parallelism: prefetch,
+	}).Collect(ctx)
 }
@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"golang.org/x/time/rate"
@@ -30,6 +31,10 @@ func CLI(ui cli.Ui) cli.CommandFactory {
 type Config struct {
 	ClientID     string
 	ClientSecret string
+	Profile      string
+	TokenStore   vkcli.StoreConfig
+	OwnerID      int
+	Domain       string
 	Force        bool
 	PreviewSize  int
 	ForceLimit   int
@@ -39,6 +44,7 @@ type Config struct {
 	OnlyOwner    bool
 	Store        bool
 	StoreDir     string
+	StoreMedia   bool
 }
 
 func (c *Config) ExportTo(flag *flag.FlagSet) {
@@ -50,6 +56,19 @@ func (c *Config) ExportTo(flag *flag.FlagSet) {
 		"client_secret", "",
 		"application secret",
 	)
+	flag.StringVar(&c.Profile,
+		"profile", vkcli.DefaultProfile,
+		"name of stored token profile",
+	)
+	c.TokenStore.ExportTo(flag)
+	flag.IntVar(&c.OwnerID,
+		"owner_id", 0,
+		"id of the wall owner (negative for communities); defaults to the token owner",
+	)
+	flag.StringVar(&c.Domain,
+		"domain", "",
+		"short name of the wall owner; used if owner_id is not set",
+	)
 	flag.BoolVar(&c.Force,
 		"force", false,
 		"do not ask for deletion",
@@ -86,6 +105,10 @@ func (c *Config) ExportTo(flag *flag.FlagSet) {
 		"store_dir", download.GetDefaultDest("posts"),
 		"store posts json backup dir",
 	)
+	flag.BoolVar(&c.StoreMedia,
+		"store_media", false,
+		"store attachments of removed posts within backup dir",
+	)
 }
 
 type Command struct {
@@ -93,6 +116,11 @@ type Command struct {
 	flag   *flag.FlagSet
 	config *Config
 	limit  *rate.Limiter
+
+	// batch is used to look up post authors within execute calls.
+	batch *vk.Batcher
+	// authors caches descriptions of post authors by their ids.
+	authors map[int]string
 }
 
 func New(ui cli.Ui) *Command {
@@ -101,15 +129,21 @@ func New(ui cli.Ui) *Command {
 
 	c := new(Config)
 	c.ExportTo(flag)
+	c.TokenStore.Log = os.Stderr
 
+	limit := rate.NewLimiter(
+		rate.Every(vk.DefaultRateInterval),
+		vk.DefaultRateBurst,
+	)
 	return &Command{
 		ui:     ui,
 		flag:   flag,
 		config: c,
-		limit: rate.NewLimiter(
-			rate.Every(vk.DefaultRateInterval),
-			vk.DefaultRateBurst,
-		),
+		limit:  limit,
+		batch: &vk.Batcher{
+			Limiter: limit,
+		},
+		authors: make(map[int]string),
 	}
 }
 
@@ -125,20 +159,20 @@ func (c *Command) Run(args []string) int {
 		ClientSecret: c.config.ClientSecret,
 		Scope:        vk.ScopeWall,
 	}
-	access, err := vkcli.AuthorizeStandalone(ctx, app)
+	access, err := c.config.TokenStore.Login(ctx, c.config.Profile, app)
 	if err != nil {
 		c.errorf("authorize error: %v", err)
 		return 1
 	}
 
-	var filter string
+	var filter vk.WallFilter
 	switch {
 	case c.config.OnlyOthers:
-		filter = "others"
+		filter = vk.WallFilterOthers
 	case c.config.OnlyOwner || c.config.OnlyReposts:
-		filter = "owner"
+		filter = vk.WallFilterOwner
 	default:
-		filter = "all"
+		filter = vk.WallFilterAll
 	}
 
 	var (
@@ -151,7 +185,7 @@ func (c *Command) Run(args []string) int {
 		if err != nil {
 			panic(err)
 		}
-		backup, err = os.Create(filepath.Clean(destDir + "/" + filter + ".backup." + strconv.FormatInt(time.Now().Unix(), 16) + ".json"))
+		backup, err = os.Create(filepath.Clean(destDir + "/" + string(filter) + ".backup." + strconv.FormatInt(time.Now().Unix(), 16) + ".json"))
 		if err != nil {
 			panic(err)
 		}
@@ -161,81 +195,96 @@ func (c *Command) Run(args []string) int {
 		defer bbuf.Flush()
 	}
 
-	var list vk.Posts
-	it := vk.Iterator{
-		Method: "wall.get",
-		Options: vk.QueryOptions(
-			vk.WithAccessToken(access),
-			vk.WithNumber("owner_id", access.UserID),
-			vk.WithNumber("count", 100),
-			vk.WithParam("filter", filter),
-		),
-		Parse: func(p []byte) (int, error) {
-			if bbuf != nil {
-				bbuf.Write(p)
-			}
-			list = vk.Posts{} // Reset.
-			err := list.UnmarshalJSON(p)
-			return len(list.Items), err
-		},
+	wall := vk.WallService{
+		Token:   access,
+		Limiter: c.limit,
+	}
+	it := wall.Iterator(vk.WallQuery{
+		OwnerID: c.config.OwnerID,
+		Domain:  c.config.Domain,
+		Filter:  filter,
+	})
+	it.Parse = func(p []byte) (int, error) {
+		if bbuf != nil {
+			bbuf.Write(p)
+		}
+		if !c.config.Force || c.config.ForcePreview {
+			c.lookupAuthors(ctx, access, p)
+		}
+		return 0, nil
 	}
+	p := vk.NewPager[vk.Post](it)
 
-	for it.Next(ctx) {
-		for _, post := range list.Items {
-			if c.config.OnlyReposts && len(post.CopyHistory) == 0 {
-				continue
+	for p.Next(ctx) {
+		post := p.Item()
+		if c.config.OnlyReposts && len(post.CopyHistory) == 0 {
+			continue
+		}
+		if !c.config.Force {
+			action, err := vkcli.AskRune(ctx, fmt.Sprintf(
+				"delete post dated %s: %s (%s)? ",
+				post.Date.Format(time.RFC3339),
+				c.postPreview(ctx, access, post),
+				homePage(post),
+			))
+			if err != nil {
+				log.Fatal(err)
 			}
-			if !c.config.Force {
-				action, err := vkcli.AskRune(ctx, fmt.Sprintf(
-					"delete post dated %s: %s (%s)? ",
-					time.Unix(int64(post.Date), 0).Format(time.RFC3339),
-					c.postPreview(ctx, access, post),
-					homePage(access, post),
-				))
-				if err != nil {
-					log.Fatal(err)
-				}
-				if action != 'y' {
-					continue
-				}
+			if action != 'y' {
+				continue
 			}
+		}
 
-		retry:
-			err = c.deletePost(ctx, access, strconv.Itoa(post.ID))
-			if vk.TemporaryError(err) {
-				goto retry
-			}
-			if err != nil {
-				log.Fatal(err)
+		if c.config.Store && c.config.StoreMedia {
+			c.storeMedia(ctx, post)
+		}
+
+		err = wall.Delete(ctx, post.Ref())
+		if err != nil {
+			log.Fatal(err)
+		}
+		if c.config.Force {
+			if c.config.ForcePreview {
+				fmt.Printf(
+					"removed post: %s: %s\n",
+					post.Date.Format(time.RFC3339),
+					c.postPreview(ctx, access, post),
+				)
+			} else {
+				fmt.Printf(
+					"removed post: %s\n",
+					post.Date.Format(time.RFC3339),
+				)
 			}
-			if c.config.Force {
-				if c.config.ForcePreview {
-					fmt.Printf(
-						"removed post: %s: %s\n",
-						time.Unix(int64(post.Date), 0).Format(time.RFC3339),
-						c.postPreview(ctx, access, post),
-					)
-				} else {
-					fmt.Printf(
-						"removed post: %s\n",
-						time.Unix(int64(post.Date), 0).Format(time.RFC3339),
-					)
-				}
-				c.config.ForceLimit--
-				if c.config.ForceLimit == 0 {
-					// Turn off force.
-					c.config.Force = false
-				}
+			c.config.ForceLimit--
+			if c.config.ForceLimit == 0 {
+				// Turn off force.
+				c.config.Force = false
 			}
 		}
 	}
-	if err := it.Err(); err != nil {
+	if err := p.Err(); err != nil {
 		log.Fatal(err)
 	}
 
 	return 0
 }
 
+func (c *Command) storeMedia(ctx context.Context, post vk.Post) {
+	destDir := filepath.Join(c.config.StoreDir, "media")
+	if err := os.MkdirAll(destDir, os.ModePerm); err != nil {
+		log.Fatal(err)
+	}
+	for _, media := range post.Media() {
+		if err := download.Media(ctx, destDir, media); err != nil {
+			log.Printf(
+				"download post %d attachment %s %s error: %v",
+				post.ID, media.Type, media.URL, err,
+			)
+		}
+	}
+}
+
 func (c *Command) postPreview(ctx context.Context, access *vk.AccessToken, post vk.Post) (text string) {
 	if n := len(post.CopyHistory); n > 0 {
 		post = post.CopyHistory[0]
@@ -247,35 +296,91 @@ func (c *Command) postPreview(ctx context.Context, access *vk.AccessToken, post
 			text = post.Text
 		}
 	}
-	if err := c.limit.Wait(ctx); err != nil {
-		return text
+	return c.author(ctx, access, post.FromID) + ": " + strconv.Quote(text)
+}
+
+// lookupAuthors looks up authors of posts of the raw page concurrently so
+// the lookups are sent within few execute calls.
+func (c *Command) lookupAuthors(ctx context.Context, access *vk.AccessToken, p []byte) {
+	var page vk.Posts
+	if err := page.UnmarshalJSON(p); err != nil {
+		return
+	}
+	var ids []int
+	for _, post := range page.Items {
+		if n := len(post.CopyHistory); n > 0 {
+			post = post.CopyHistory[0]
+		}
+		if _, ok := c.authors[post.FromID]; !ok {
+			c.authors[post.FromID] = ""
+			ids = append(ids, post.FromID)
+		}
+	}
+	var (
+		wg      sync.WaitGroup
+		authors = make([]string, len(ids))
+	)
+	for i, id := range ids {
+		wg.Add(1)
+		go func(i, id int) {
+			defer wg.Done()
+			authors[i], _ = c.lookupAuthor(ctx, access, id)
+		}(i, id)
 	}
-	if post.FromID < 0 {
-		owner, _ := getGroup(ctx, access, post.FromID)
-		text = fmt.Sprintf(
-			"from group %q: %q",
-			owner.Name, text,
-		)
-	} else {
-		user, _ := getUser(ctx, access, post.FromID)
-		text = fmt.Sprintf(
-			"from user %s %s (%s): %q",
-			user.FirstName, user.LastName, user.Domain,
-			text,
-		)
+	wg.Wait()
+	for i, id := range ids {
+		if authors[i] == "" {
+			delete(c.authors, id)
+		} else {
+			c.authors[id] = authors[i]
+		}
 	}
+}
 
-	return text
+// author returns description of user or group (negative id) with given id.
+func (c *Command) author(ctx context.Context, access *vk.AccessToken, id int) string {
+	if s := c.authors[id]; s != "" {
+		return s
+	}
+	s, err := c.lookupAuthor(ctx, access, id)
+	if err != nil {
+		if id < 0 {
+			return "from group " + strconv.Itoa(-id)
+		}
+		return "from user " + strconv.Itoa(id)
+	}
+	c.authors[id] = s
+	return s
 }
 
-func getGroup(ctx context.Context, access *vk.AccessToken, groupID int) (group vk.Group, err error) {
-	bts, err := vk.Request(ctx, "groups.getById",
-		vk.WithAccessToken(access),
-		vk.WithNumber("group_id", -1*groupID),
-	)
-	if err == nil {
-		bts, err = vk.StripResponse(bts)
+func (c *Command) lookupAuthor(ctx context.Context, access *vk.AccessToken, id int) (string, error) {
+	if id < 0 {
+		group, err := getGroup(ctx, access, c.batch, id)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("from group %q", group.Name), nil
 	}
+	user, err := getUser(ctx, access, c.batch, id)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(
+		"from user %s %s (%s)",
+		user.FirstName, user.LastName, user.Domain,
+	), nil
+}
+
+func getGroup(ctx context.Context, access *vk.AccessToken, batch *vk.Batcher, groupID int) (group vk.Group, err error) {
+	call := vk.Caller{
+		Method: "groups.getById",
+		Options: vk.QueryOptions(
+			vk.WithAccessToken(access),
+			vk.WithNumber("group_id", -1*groupID),
+		),
+		Batcher: batch,
+	}
+	bts, err := call.Call(ctx)
 	if err != nil {
 		return group, err
 	}
@@ -292,27 +397,14 @@ func getGroup(ctx context.Context, access *vk.AccessToken, groupID int) (group v
 	return group, err
 }
 
-func getUser(ctx context.Context, access *vk.AccessToken, userID int) (user vk.User, err error) {
-	bts, err := vk.Request(ctx, "users.get",
-		vk.WithAccessToken(access),
-		vk.WithNumber("user_ids", userID),
-		vk.WithStrings("fields", "domain"),
-	)
-	if err == nil {
-		bts, err = vk.StripResponse(bts)
+func getUser(ctx context.Context, access *vk.AccessToken, batch *vk.Batcher, userID int) (user vk.User, err error) {
+	users := vk.UsersService{
+		Token:   access,
+		Batcher: batch,
 	}
-	if err != nil {
-		return user, err
-	}
-
-	// Need to hack up response.
-	bts = append([]byte(`{"items":`), bts...)
-	bts = append(bts, '}')
-
-	var list vk.Users
-	err = list.UnmarshalJSON(bts)
-	if len(list.Items) > 0 {
-		user = list.Items[0]
+	list, err := users.Get(ctx, []int{userID}, vk.UserFieldDomain)
+	if len(list) > 0 {
+		user = list[0]
 	}
 	return user, err
 }
@@ -340,21 +432,6 @@ func (c *Command) Help() string {
 	}, "\n")
 }
 
-func homePage(access *vk.AccessToken, post vk.Post) string {
-	return "https://vk.com/wall" + strconv.Itoa(access.UserID) + "_" + strconv.Itoa(post.ID)
-}
-
-func (c *Command) deletePost(ctx context.Context, access *vk.AccessToken, postID string) error {
-	if err := c.limit.Wait(ctx); err != nil {
-		return err
-	}
-	bts, err := vk.Request(ctx, "wall.delete",
-		vk.WithAccessToken(access),
-		vk.WithParam("owner_id", strconv.Itoa(access.UserID)),
-		vk.WithParam("post_id", postID),
-	)
-	if err == nil {
-		_, err = vk.StripResponse(bts)
-	}
-	return err
+func homePage(post vk.Post) string {
+	return "https://vk.com/wall" + post.Ref().String()
 }
@@ -22,6 +22,8 @@ func CLI(ui cli.Ui) cli.CommandFactory {
 type Config struct {
 	ClientID     string
 	ClientSecret string
+	Profile      string
+	TokenStore   vkcli.StoreConfig
 	Token        string
 }
 
@@ -34,6 +36,11 @@ func (c *Config) ExportTo(flag *flag.FlagSet) {
 		"client_secret", "",
 		"application secret",
 	)
+	flag.StringVar(&c.Profile,
+		"profile", vkcli.DefaultProfile,
+		"name of stored token profile",
+	)
+	c.TokenStore.ExportTo(flag)
 	flag.StringVar(&c.Token,
 		"token", "",
 		"token retreived before",
@@ -52,6 +59,7 @@ func New(ui cli.Ui) *Command {
 
 	c := new(Config)
 	c.ExportTo(flag)
+	c.TokenStore.Log = os.Stderr
 
 	return &Command{
 		ui:     ui,
@@ -73,7 +81,7 @@ func (c *Command) Run(args []string) int {
 		return 1
 	}
 
-	panic(access.Token)
+	c.ui.Output(access.Token)
 
 	return 0
 }
@@ -87,7 +95,7 @@ func (c *Command) Authorize(ctx context.Context) (*vk.AccessToken, error) {
 		ClientSecret: c.config.ClientSecret,
 		Scope:        vk.ScopeMessages,
 	}
-	return vkcli.AuthorizeStandalone(ctx, app)
+	return c.config.TokenStore.Login(ctx, c.config.Profile, app)
 }
 
 func (c *Command) errorf(f string, args ...interface{}) {
@@ -0,0 +1,151 @@
+package whoami
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gobwas/vk"
+	vkcli "github.com/gobwas/vk/cli"
+	"github.com/mitchellh/cli"
+)
+
+func CLI(ui cli.Ui) cli.CommandFactory {
+	return func() (cli.Command, error) {
+		return New(ui), nil
+	}
+}
+
+type Config struct {
+	ClientID   string
+	Profile    string
+	TokenStore vkcli.StoreConfig
+}
+
+func (c *Config) ExportTo(flag *flag.FlagSet) {
+	flag.StringVar(&c.ClientID,
+		"client_id", "",
+		"application id (empty for all applications)",
+	)
+	flag.StringVar(&c.Profile,
+		"profile", vkcli.DefaultProfile,
+		"name of stored token profile",
+	)
+	c.TokenStore.ExportTo(flag)
+}
+
+type Command struct {
+	ui     cli.Ui
+	flag   *flag.FlagSet
+	config *Config
+}
+
+func New(ui cli.Ui) *Command {
+	flag := flag.NewFlagSet("", flag.ContinueOnError)
+	flag.Usage = func() {}
+
+	c := new(Config)
+	c.ExportTo(flag)
+
+	return &Command{
+		ui:     ui,
+		flag:   flag,
+		config: c,
+	}
+}
+
+func (c *Command) Run(args []string) int {
+	if err := c.flag.Parse(args); err != nil {
+		return cli.RunResultHelp
+	}
+
+	ctx := context.Background()
+
+	store, err := c.config.TokenStore.Open(ctx)
+	if err != nil {
+		c.errorf("open token store error: %v", err)
+		return 1
+	}
+	tokens, err := store.List(c.config.Profile)
+	if err != nil {
+		c.errorf("list tokens error: %v", err)
+		return 1
+	}
+	var n int
+	for _, t := range tokens {
+		if id := c.config.ClientID; id != "" && t.ClientID != id {
+			continue
+		}
+		n++
+
+		access := t.Token
+		who := fmt.Sprintf("user %d", access.UserID)
+		if vkcli.Usable(access, 0) {
+			user, err := getUser(ctx, access)
+			if err != nil {
+				who += fmt.Sprintf(" (%v)", err)
+			} else {
+				who = fmt.Sprintf("%s %s (%d)", user.FirstName, user.LastName, user.ID)
+			}
+		}
+		c.ui.Output(fmt.Sprintf(
+			"%s: client %s: %s; scope %s; %s",
+			t.Profile, t.ClientID, who, strings.Join(access.Scope.Names(), ","), expiry(access),
+		))
+	}
+	if n == 0 {
+		c.ui.Output(fmt.Sprintf("not logged in (profile %q)", c.config.Profile))
+		return 1
+	}
+
+	return 0
+}
+
+func expiry(access *vk.AccessToken) string {
+	switch {
+	case access.Expires.IsZero():
+		return "never expires"
+	case time.Now().After(access.Expires):
+		return "expired at " + access.Expires.Format(time.RFC3339)
+	default:
+		return "expires at " + access.Expires.Format(time.RFC3339)
+	}
+}
+
+func getUser(ctx context.Context, access *vk.AccessToken) (user vk.User, err error) {
+	users := vk.UsersService{
+		Token: access,
+	}
+	list, err := users.Get(ctx, nil)
+	if len(list) > 0 {
+		user = list[0]
+	}
+	return user, err
+}
+
+func (c *Command) errorf(f string, args ...interface{}) {
+	c.ui.Error(fmt.Sprintf(f, args...))
+}
+
+func (c *Command) flagDefaults() string {
+	var buf bytes.Buffer
+	c.flag.SetOutput(&buf)
+	c.flag.PrintDefaults()
+	c.flag.SetOutput(os.Stderr)
+	return buf.String()
+}
+
+func (c *Command) Synopsis() string {
+	return "show stored access tokens"
+}
+
+func (c *Command) Help() string {
+	return strings.Join([]string{
+		"Usage: whoami [options]",
+		c.flagDefaults(),
+	}, "\n")
+}
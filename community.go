@@ -0,0 +1,234 @@
+package vk
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mailru/easyjson/jlexer"
+)
+
+// GroupScope is a set of permissions of community access token.
+type GroupScope uint64
+
+const (
+	GroupScopeStories   GroupScope = 1 << 0
+	GroupScopePhotos    GroupScope = 1 << 2
+	GroupScopeAppWidget GroupScope = 1 << 6
+	GroupScopeMessages  GroupScope = 1 << 12
+	GroupScopeDocs      GroupScope = 1 << 17
+	GroupScopeManage    GroupScope = 1 << 18
+)
+
+var groupScopeNames = []struct {
+	scope GroupScope
+	name  string
+}{
+	{GroupScopeStories, "stories"},
+	{GroupScopePhotos, "photos"},
+	{GroupScopeAppWidget, "app_widget"},
+	{GroupScopeMessages, "messages"},
+	{GroupScopeDocs, "docs"},
+	{GroupScopeManage, "manage"},
+}
+
+// ParseGroupScope is like ParseScope but for community permissions.
+func ParseGroupScope(s string) (scope GroupScope, err error) {
+	if n, err := strconv.ParseUint(s, 10, 64); err == nil {
+		return GroupScope(n), nil
+	}
+	for _, name := range strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ' '
+	}) {
+		var ok bool
+		for _, x := range groupScopeNames {
+			if x.name == name {
+				scope |= x.scope
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return 0, fmt.Errorf("vk: unknown group scope: %q", name)
+		}
+	}
+	return scope, nil
+}
+
+// Has reports whether s contains all permissions of x.
+func (s GroupScope) Has(x GroupScope) bool {
+	return s&x == x
+}
+
+// Names returns names of permissions contained in s. Unknown bits are
+// ignored.
+func (s GroupScope) Names() []string {
+	var names []string
+	for _, x := range groupScopeNames {
+		if s.Has(x.scope) {
+			names = append(names, x.name)
+		}
+	}
+	return names
+}
+
+// String returns decimal bit mask which is accepted by the API.
+func (s GroupScope) String() string {
+	return strconv.FormatUint(uint64(s), 10)
+}
+
+// GroupTokens maps community id to its access token.
+type GroupTokens map[int]*AccessToken
+
+// IDs returns sorted ids of communities.
+func (g GroupTokens) IDs() []int {
+	ids := make([]int, 0, len(g))
+	for id := range g {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+// GroupAuthPathCode returns url to authorize access to communities with
+// given ids using authorization code flow.
+func (a *App) GroupAuthPathCode(redirect string, scope GroupScope, groupIDs []int, options ...QueryOption) string {
+	return a.authorizePath(redirect, "code", groupAuthOptions(scope, groupIDs, options)...)
+}
+
+// GroupAuthPathToken returns url to authorize access to communities with
+// given ids using implicit flow.
+func (a *App) GroupAuthPathToken(redirect string, scope GroupScope, groupIDs []int, options ...QueryOption) string {
+	return a.authorizePath(redirect, "token", groupAuthOptions(scope, groupIDs, options)...)
+}
+
+func groupAuthOptions(scope GroupScope, groupIDs []int, options []QueryOption) []QueryOption {
+	return append([]QueryOption{
+		WithStrings("scope", scope.String()),
+		WithNumbers("group_ids", groupIDs...),
+	}, options...)
+}
+
+// AuthorizeGroups exchanges code received after redirect to the url returned
+// by GroupAuthPathCode() for community access tokens.
+func (a *App) AuthorizeGroups(ctx context.Context, redirectPath, code string, scope GroupScope) (GroupTokens, error) {
+	bts, err := a.oauth(ctx, a.AccessTokenPath(redirectPath, code))
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := parseGroupTokens(bts)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tokens {
+		t.GroupScope = scope
+	}
+	return tokens, nil
+}
+
+const groupTokenPrefix = "access_token_"
+
+// parseGroupTokens parses response like
+// {"access_token_1":"...","access_token_2":"...","expires_in":0}.
+func parseGroupTokens(p []byte) (GroupTokens, error) {
+	var (
+		in      = jlexer.Lexer{Data: p}
+		tokens  = make(GroupTokens)
+		expires int
+	)
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		switch {
+		case key == "expires_in":
+			expires = in.Int()
+		case strings.HasPrefix(key, groupTokenPrefix):
+			id, err := strconv.Atoi(key[len(groupTokenPrefix):])
+			if err != nil {
+				return nil, fmt.Errorf("vk: bad group token key: %q", key)
+			}
+			tokens[id] = &AccessToken{
+				Token:   in.String(),
+				GroupID: id,
+				Kind:    TokenCommunity,
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	in.Consumed()
+	if err := in.Error(); err != nil {
+		return nil, err
+	}
+	for _, t := range tokens {
+		t.Expires = expiresDate(expires)
+	}
+	return tokens, nil
+}
+
+// GroupTokensFromURL parses community tokens from the url fragment after
+// redirect to the url returned by GroupAuthPathToken().
+func GroupTokensFromURL(str string) (GroupTokens, error) {
+	u, err := url.Parse(str)
+	if err != nil {
+		return nil, err
+	}
+	params, err := url.ParseQuery(u.Fragment)
+	if err != nil {
+		return nil, err
+	}
+	return GroupTokensFromQuery(params)
+}
+
+// GroupTokensFromQuery is like GroupTokensFromURL() but works with already
+// parsed parameters.
+func GroupTokensFromQuery(query url.Values) (GroupTokens, error) {
+	if err := RedirectQueryError(query); err != nil {
+		return nil, err
+	}
+	expires, err := strconv.Atoi(query.Get("expires_in"))
+	if err != nil {
+		return nil, err
+	}
+	tokens := make(GroupTokens)
+	for key := range query {
+		if !strings.HasPrefix(key, groupTokenPrefix) {
+			continue
+		}
+		id, err := strconv.Atoi(key[len(groupTokenPrefix):])
+		if err != nil {
+			return nil, fmt.Errorf("vk: bad group token key: %q", key)
+		}
+		tokens[id] = &AccessToken{
+			Token:   query.Get(key),
+			GroupID: id,
+			Kind:    TokenCommunity,
+			Expires: expiresDate(expires),
+		}
+	}
+	return tokens, nil
+}
+
+// GroupPermissions returns permissions of community token.
+func (c *Client) GroupPermissions(ctx context.Context, token *AccessToken) (GroupScope, error) {
+	bts, err := c.Request(ctx, "groups.getTokenPermissions",
+		WithAccessToken(token),
+	)
+	if err == nil {
+		bts, err = StripResponse(bts)
+	}
+	if err != nil {
+		return 0, err
+	}
+	var perm rawGroupPermissions
+	if err := perm.UnmarshalJSON(bts); err != nil {
+		return 0, err
+	}
+	return GroupScope(perm.Mask), nil
+}
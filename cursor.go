@@ -0,0 +1,151 @@
+package vk
+
+import "strconv"
+
+// Cursor defines how Iterator requests consecutive pages.
+type Cursor interface {
+	// Options returns options which select the current page.
+	Options() []QueryOption
+
+	// Advance moves cursor to the page next to the given one. It returns
+	// false if there are no more pages.
+	Advance(page Page) bool
+}
+
+// Page describes a page received by Iterator.
+type Page struct {
+	// Items is the number of items returned by Iterator's Parse function.
+	Items int
+
+	// Count is the total number of items reported by response. It is -1 if
+	// response has no count field.
+	Count int
+
+	// NextFrom is the next_from field of the response.
+	NextFrom string
+
+	// LastID is the id of the last item in response. It is zero if the last
+	// item has no id.
+	LastID int
+}
+
+// OffsetCursor selects pages by numeric offset. It is used by Iterator by
+// default.
+type OffsetCursor struct {
+	// Param is the name of offset parameter. If empty, "offset" is used.
+	Param string
+
+	offset int
+}
+
+// Options implements Cursor.
+func (c *OffsetCursor) Options() []QueryOption {
+	return []QueryOption{
+		WithNumber(c.param(), c.offset),
+	}
+}
+
+// Advance implements Cursor.
+func (c *OffsetCursor) Advance(page Page) bool {
+	c.offset += page.Items
+	return true
+}
+
+func (c *OffsetCursor) param() string {
+	if c.Param == "" {
+		return "offset"
+	}
+	return c.Param
+}
+
+// NextFromCursor selects pages by string cursor which is returned within
+// next_from response field. It is used by methods like newsfeed.get.
+type NextFromCursor struct {
+	// Param is the name of cursor parameter. If empty, "start_from" is used.
+	Param string
+
+	next string
+}
+
+// Options implements Cursor.
+func (c *NextFromCursor) Options() []QueryOption {
+	if c.next == "" {
+		return nil
+	}
+	param := c.Param
+	if param == "" {
+		param = "start_from"
+	}
+	return []QueryOption{
+		WithParam(param, c.next),
+	}
+}
+
+// Advance implements Cursor.
+func (c *NextFromCursor) Advance(page Page) bool {
+	c.next = page.NextFrom
+	return c.next != ""
+}
+
+// StartIDCursor selects pages by id of the item to start from. It is used by
+// methods like wall.getComments with start_comment_id or
+// messages.getConversations with start_message_id parameters.
+type StartIDCursor struct {
+	// Param is the name of start id parameter, e.g. "start_comment_id".
+	Param string
+
+	// ID is an optional function which returns id to start the next page
+	// from. If nil, Page's LastID is used.
+	ID func(Page) int
+
+	id int
+}
+
+// Options implements Cursor.
+func (c *StartIDCursor) Options() []QueryOption {
+	if c.id == 0 {
+		return nil
+	}
+	return []QueryOption{
+		WithNumber(c.Param, c.id),
+		// Skip the item we start from, cause it was received within
+		// previous page.
+		WithNumber("offset", 1),
+	}
+}
+
+// Advance implements Cursor.
+func (c *StartIDCursor) Advance(page Page) bool {
+	id := page.LastID
+	if c.ID != nil {
+		id = c.ID(page)
+	}
+	c.id = id
+	return id != 0
+}
+
+func parsePage(p []byte, n int) Page {
+	page := Page{
+		Items: n,
+		Count: -1,
+	}
+	var meta pageMeta
+	if meta.UnmarshalJSON(p) != nil {
+		return page
+	}
+	if meta.Count != nil {
+		page.Count = *meta.Count
+	}
+	page.NextFrom = meta.NextFrom
+	if n := len(meta.Items); n > 0 {
+		last := []byte(meta.Items[n-1])
+		var item pageItem
+		if item.UnmarshalJSON(last) == nil {
+			page.LastID = item.ID
+		} else {
+			// Some methods return just list of ids.
+			page.LastID, _ = strconv.Atoi(string(last))
+		}
+	}
+	return page
+}
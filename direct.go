@@ -0,0 +1,175 @@
+package vk
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gobwas/vk/internal/httputil"
+)
+
+// MaxDirectAttempts is the maximum number of requests made by
+// App.AuthorizeDirect() while passing validation and captcha challenges.
+const MaxDirectAttempts = 5
+
+// OAuthError is an error returned by OAuth server.
+type OAuthError struct {
+	Err         string
+	Description string
+}
+
+func (e *OAuthError) Error() string {
+	if e.Description == "" {
+		return "vk: oauth: " + e.Err
+	}
+	return fmt.Sprintf("vk: oauth: %s: %s", e.Err, e.Description)
+}
+
+// Validation describes two-factor authentication challenge.
+type Validation struct {
+	// Type is a type of validation, e.g. "2fa_sms" or "2fa_app".
+	Type string
+
+	SID       string
+	PhoneMask string
+
+	// RedirectURI is an url of validation page which could be used instead
+	// of code.
+	RedirectURI string
+}
+
+// DirectOptions contains optional parameters of direct authorization.
+type DirectOptions struct {
+	// Code returns two-factor authentication code. If nil, validation
+	// challenge results in *OAuthError.
+	Code func(ctx context.Context, v *Validation) (string, error)
+
+	// ResolveCaptcha returns text from captcha image. If nil, captcha
+	// challenge results in *OAuthError.
+	ResolveCaptcha func(ctx context.Context, img string) (text string, err error)
+}
+
+// AuthorizeDirect authorizes user by username and password using password
+// grant. Note that only trusted apps are allowed to do so.
+func (a *App) AuthorizeDirect(ctx context.Context, username, password string, opts *DirectOptions) (*AccessToken, error) {
+	if opts == nil {
+		opts = new(DirectOptions)
+	}
+	query := url.Values{
+		"grant_type":    []string{"password"},
+		"client_id":     []string{a.ClientID},
+		"client_secret": []string{a.ClientSecret},
+		"username":      []string{username},
+		"password":      []string{password},
+		"scope":         []string{a.Scope.String()},
+		"v":             []string{clientOrDefault(a.Client).version()},
+		"2fa_supported": []string{"1"},
+	}
+	// answer holds validation code and captcha answer for the next attempt
+	// only.
+	var answer url.Values
+	for attempt := 0; attempt < MaxDirectAttempts; attempt++ {
+		values := make(url.Values, len(query)+len(answer))
+		for key, vs := range query {
+			values[key] = vs
+		}
+		for key, vs := range answer {
+			values[key] = vs
+		}
+		answer = nil
+
+		resp, err := a.direct(ctx, values)
+		if err != nil {
+			return nil, err
+		}
+		switch resp.Err {
+		case "":
+			return &AccessToken{
+				Token:   resp.Token,
+				UserID:  resp.UserID,
+				Expires: expiresDate(resp.Expires),
+				Scope:   a.Scope,
+			}, nil
+
+		case "need_validation":
+			if opts.Code == nil {
+				return nil, resp.oauthError()
+			}
+			code, err := opts.Code(ctx, &Validation{
+				Type:        resp.ValidationType,
+				SID:         resp.ValidationSID,
+				PhoneMask:   resp.PhoneMask,
+				RedirectURI: resp.RedirectURI,
+			})
+			if err != nil {
+				return nil, err
+			}
+			answer = url.Values{
+				"code": []string{code},
+			}
+
+		case "need_captcha":
+			if opts.ResolveCaptcha == nil {
+				return nil, resp.oauthError()
+			}
+			text, err := opts.ResolveCaptcha(ctx, resp.CaptchaImg)
+			if err != nil {
+				return nil, err
+			}
+			answer = url.Values{
+				"captcha_sid": []string{resp.CaptchaSID},
+				"captcha_key": []string{text},
+			}
+
+		default:
+			return nil, resp.oauthError()
+		}
+	}
+	return nil, fmt.Errorf("vk: direct authorization: too many attempts")
+}
+
+// direct makes password grant request. Credentials are sent within POST
+// body so they never appear in the url and thus in the transport errors.
+func (a *App) direct(ctx context.Context, query url.Values) (*rawDirect, error) {
+	client := clientOrDefault(a.Client)
+	req, err := http.NewRequest("POST", client.oauthURL()+"/token",
+		strings.NewReader(query.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := client.httpClient().Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bts, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var ret rawDirect
+	err = ret.UnmarshalJSON(bts)
+	if err == nil && ret.Err != "" {
+		// Errors are reported with 401 status code and JSON body.
+		return &ret, nil
+	}
+	if err := httputil.CheckResponseStatus(resp); err != nil {
+		return nil, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+func (r *rawDirect) oauthError() error {
+	return &OAuthError{
+		Err:         r.Err,
+		Description: r.ErrorDescription,
+	}
+}
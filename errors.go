@@ -1,7 +1,21 @@
 package vk
 
+import (
+	"errors"
+	"strconv"
+)
+
+// ErrorCode represents VK API error code.
+//
+// ErrorCode implements error interface so its constants could be used as
+// sentinel errors:
+//
+//	if errors.Is(err, vk.ErrRateLimitExceeded) {
+//		// Slow down.
+//	}
 type ErrorCode int
 
+// General errors.
 const (
 	ErrEmpty                        ErrorCode = 0
 	ErrUnknown                      ErrorCode = 1
@@ -20,21 +34,320 @@ const (
 	ErrSecureLayerRequired          ErrorCode = 16
 	ErrUserValidationRequired       ErrorCode = 17
 	ErrPageRemovedOrBlocked         ErrorCode = 18
+	ErrContentBlocked               ErrorCode = 19
 	ErrNotStandaloneProhibited      ErrorCode = 20
 	ErrOnlyStandaloneAllowed        ErrorCode = 21
+	ErrUploadError                  ErrorCode = 22
 	ErrMethodDepricated             ErrorCode = 23
 	ErrUserPermissionRequired       ErrorCode = 24
+	ErrTokenConfirmationRequired    ErrorCode = 25
 	ErrInvalidCommunityAccessCode   ErrorCode = 27
 	ErrInvalidApplicationAccessCode ErrorCode = 28
+	ErrMethodRateLimitReached       ErrorCode = 29
+	ErrPrivateProfile               ErrorCode = 30
+	ErrNotImplemented               ErrorCode = 33
+	ErrClientVersionDeprecated      ErrorCode = 34
+	ErrExecutionTimeout             ErrorCode = 36
+	ErrUserBanned                   ErrorCode = 37
+	ErrUnknownApplication           ErrorCode = 38
+	ErrUnknownUser                  ErrorCode = 39
+	ErrMethodDeprecatedSoon         ErrorCode = 40
+	ErrAdditionalSignupRequired     ErrorCode = 41
 	ErrInsufficientParameters       ErrorCode = 100
 	ErrBadAPIID                     ErrorCode = 101
+	ErrOutOfLimits                  ErrorCode = 103
+	ErrNotFound                     ErrorCode = 104
 	ErrBadUserID                    ErrorCode = 113
 	ErrBadTimestamp                 ErrorCode = 150
 	ErrAccessDeniedForAlbum         ErrorCode = 200
 	ErrAccessDeniedForAudio         ErrorCode = 201
 	ErrAccessDeniedForGroup         ErrorCode = 203
+	ErrAccessDeniedForWallPost      ErrorCode = 210
+	ErrAccessDeniedForWallComment   ErrorCode = 211
+	ErrAccessDeniedForPostComments  ErrorCode = 212
+	ErrAccessDeniedForPostAdding    ErrorCode = 214
+	ErrAdsPostRecentlyAdded         ErrorCode = 219
+	ErrTooManyRecipients            ErrorCode = 220
+	ErrHyperlinksForbidden          ErrorCode = 222
+	ErrTooManyAdsPosts              ErrorCode = 224
+	ErrAccessDeniedForPoll          ErrorCode = 250
+	ErrAccessDeniedForGroupsList    ErrorCode = 260
 	ErrAlbumOverflow                ErrorCode = 300
 	ErrActionDenied                 ErrorCode = 500
 	ErrCommercialPermissionDenied   ErrorCode = 600
 	ErrCommercialError              ErrorCode = 603
 )
+
+// Execute method errors.
+const (
+	ErrExecuteCompile ErrorCode = 12
+	ErrExecuteRuntime ErrorCode = 13
+)
+
+// Messages methods errors.
+const (
+	ErrMessagesUserBlocked        ErrorCode = 900
+	ErrMessagesDenySend           ErrorCode = 901
+	ErrMessagesPrivacy            ErrorCode = 902
+	ErrMessagesEditExpired        ErrorCode = 909
+	ErrMessagesTooBig             ErrorCode = 910
+	ErrMessagesKeyboardInvalid    ErrorCode = 911
+	ErrMessagesChatBotFeature     ErrorCode = 912
+	ErrMessagesTooManyForwarded   ErrorCode = 913
+	ErrMessagesTooLong            ErrorCode = 914
+	ErrMessagesChatAccessDenied   ErrorCode = 917
+	ErrMessagesEditKindDisallowed ErrorCode = 920
+	ErrMessagesCantForward        ErrorCode = 921
+	ErrMessagesCantDeleteForAll   ErrorCode = 924
+	ErrMessagesChatNotAdmin       ErrorCode = 925
+	ErrMessagesChatNotExist       ErrorCode = 927
+	ErrMessagesContactNotFound    ErrorCode = 936
+	ErrMessagesMessageRequestSent ErrorCode = 939
+	ErrMessagesTooManyPosts       ErrorCode = 940
+	ErrMessagesCantUseIntent      ErrorCode = 943
+	ErrMessagesChatDisabled       ErrorCode = 945
+	ErrMessagesChatNotSupported   ErrorCode = 946
+	ErrMessagesCantAddToChat      ErrorCode = 947
+)
+
+var errorNames = map[ErrorCode]string{
+	ErrEmpty:                        "no error",
+	ErrUnknown:                      "unknown error",
+	ErrAppStopped:                   "application is disabled",
+	ErrBadMethod:                    "unknown method",
+	ErrBadSignature:                 "incorrect signature",
+	ErrNoAuth:                       "user authorization failed",
+	ErrRateLimitExceeded:            "too many requests per second",
+	ErrPermissionDenied:             "permission denied",
+	ErrBadRequest:                   "invalid request",
+	ErrTooManyActions:               "flood control",
+	ErrInternalError:                "internal server error",
+	ErrTestModeError:                "application in test mode",
+	ErrExecuteCompile:               "unable to compile code",
+	ErrExecuteRuntime:               "runtime error during code invocation",
+	ErrCaptchaRequired:              "captcha needed",
+	ErrAccessDenied:                 "access denied",
+	ErrSecureLayerRequired:          "https required",
+	ErrUserValidationRequired:       "validation required",
+	ErrPageRemovedOrBlocked:         "page deleted or blocked",
+	ErrContentBlocked:               "content blocked",
+	ErrNotStandaloneProhibited:      "not allowed for non-standalone applications",
+	ErrOnlyStandaloneAllowed:        "allowed only for standalone applications",
+	ErrUploadError:                  "upload error",
+	ErrMethodDepricated:             "method is disabled",
+	ErrUserPermissionRequired:       "confirmation required",
+	ErrTokenConfirmationRequired:    "token confirmation required",
+	ErrInvalidCommunityAccessCode:   "community authorization failed",
+	ErrInvalidApplicationAccessCode: "application authorization failed",
+	ErrMethodRateLimitReached:       "method rate limit reached",
+	ErrPrivateProfile:               "profile is private",
+	ErrNotImplemented:               "not implemented",
+	ErrClientVersionDeprecated:      "client version deprecated",
+	ErrExecutionTimeout:             "execution timeout",
+	ErrUserBanned:                   "user was banned",
+	ErrUnknownApplication:           "unknown application",
+	ErrUnknownUser:                  "unknown user",
+	ErrMethodDeprecatedSoon:         "method is deprecated and may be disabled soon",
+	ErrAdditionalSignupRequired:     "additional signup required",
+	ErrInsufficientParameters:       "invalid parameters",
+	ErrBadAPIID:                     "invalid application id",
+	ErrOutOfLimits:                  "out of limits",
+	ErrNotFound:                     "not found",
+	ErrBadUserID:                    "invalid user id",
+	ErrBadTimestamp:                 "invalid timestamp",
+	ErrAccessDeniedForAlbum:         "access to album denied",
+	ErrAccessDeniedForAudio:         "access to audio denied",
+	ErrAccessDeniedForGroup:         "access to group denied",
+	ErrAccessDeniedForWallPost:      "access to wall post denied",
+	ErrAccessDeniedForWallComment:   "access to wall comment denied",
+	ErrAccessDeniedForPostComments:  "access to post comments denied",
+	ErrAccessDeniedForPostAdding:    "access to adding post denied",
+	ErrAdsPostRecentlyAdded:         "ads post was recently added",
+	ErrTooManyRecipients:            "too many recipients",
+	ErrHyperlinksForbidden:          "hyperlinks are forbidden",
+	ErrTooManyAdsPosts:              "too many ads posts",
+	ErrAccessDeniedForPoll:          "access to poll denied",
+	ErrAccessDeniedForGroupsList:    "access to groups list denied",
+	ErrAlbumOverflow:                "album is full",
+	ErrActionDenied:                 "votes processing disabled",
+	ErrCommercialPermissionDenied:   "no access to ads operations",
+	ErrCommercialError:              "ads error",
+	ErrMessagesUserBlocked:          "user is in blacklist",
+	ErrMessagesDenySend:             "user denied messages",
+	ErrMessagesPrivacy:              "user privacy settings",
+	ErrMessagesEditExpired:          "message is too old to edit",
+	ErrMessagesTooBig:               "message is too big",
+	ErrMessagesKeyboardInvalid:      "invalid keyboard format",
+	ErrMessagesChatBotFeature:       "chat bot feature disabled",
+	ErrMessagesTooManyForwarded:     "too many forwarded messages",
+	ErrMessagesTooLong:              "message is too long",
+	ErrMessagesChatAccessDenied:     "access to chat denied",
+	ErrMessagesEditKindDisallowed:   "can not edit this kind of message",
+	ErrMessagesCantForward:          "can not forward messages",
+	ErrMessagesCantDeleteForAll:     "can not delete message for everybody",
+	ErrMessagesChatNotAdmin:         "not a chat admin",
+	ErrMessagesChatNotExist:         "chat does not exist",
+	ErrMessagesContactNotFound:      "contact not found",
+	ErrMessagesMessageRequestSent:   "message request already sent",
+	ErrMessagesTooManyPosts:         "too many posts in messages",
+	ErrMessagesCantUseIntent:        "can not use intent",
+	ErrMessagesChatDisabled:         "chat is disabled",
+	ErrMessagesChatNotSupported:     "chat is not supported",
+	ErrMessagesCantAddToChat:        "can not add user to chat",
+}
+
+func (c ErrorCode) String() string {
+	if name, ok := errorNames[c]; ok {
+		return name
+	}
+	return "error code " + strconv.Itoa(int(c))
+}
+
+func (c ErrorCode) Error() string {
+	return "vk: " + c.String() + " (" + strconv.Itoa(int(c)) + ")"
+}
+
+// Temporary reports whether the call failed with c could be retried later.
+func (c ErrorCode) Temporary() bool {
+	switch c {
+	case
+		ErrRateLimitExceeded,
+		ErrTooManyActions,
+		ErrInternalError:
+		return true
+	default:
+		return false
+	}
+}
+
+// Flood reports whether c is caused by too frequent calls.
+func (c ErrorCode) Flood() bool {
+	switch c {
+	case
+		ErrRateLimitExceeded,
+		ErrTooManyActions,
+		ErrMethodRateLimitReached:
+		return true
+	default:
+		return false
+	}
+}
+
+// Auth reports whether c is caused by invalid or expired authorization.
+func (c ErrorCode) Auth() bool {
+	switch c {
+	case
+		ErrBadSignature,
+		ErrNoAuth,
+		ErrSecureLayerRequired,
+		ErrUserValidationRequired,
+		ErrTokenConfirmationRequired,
+		ErrInvalidCommunityAccessCode,
+		ErrInvalidApplicationAccessCode,
+		ErrAdditionalSignupRequired:
+		return true
+	default:
+		return false
+	}
+}
+
+// Permission reports whether c is caused by lack of access rights.
+func (c ErrorCode) Permission() bool {
+	switch c {
+	case
+		ErrPermissionDenied,
+		ErrAccessDenied,
+		ErrNotStandaloneProhibited,
+		ErrOnlyStandaloneAllowed,
+		ErrUserPermissionRequired,
+		ErrPrivateProfile,
+		ErrUserBanned,
+		ErrAccessDeniedForAlbum,
+		ErrAccessDeniedForAudio,
+		ErrAccessDeniedForGroup,
+		ErrAccessDeniedForWallPost,
+		ErrAccessDeniedForWallComment,
+		ErrAccessDeniedForPostComments,
+		ErrAccessDeniedForPostAdding,
+		ErrAccessDeniedForPoll,
+		ErrAccessDeniedForGroupsList,
+		ErrActionDenied,
+		ErrCommercialPermissionDenied,
+		ErrMessagesUserBlocked,
+		ErrMessagesDenySend,
+		ErrMessagesPrivacy,
+		ErrMessagesChatAccessDenied,
+		ErrMessagesChatNotAdmin:
+		return true
+	default:
+		return false
+	}
+}
+
+// NotFound reports whether c is caused by absence of requested object.
+func (c ErrorCode) NotFound() bool {
+	switch c {
+	case
+		ErrPageRemovedOrBlocked,
+		ErrUnknownApplication,
+		ErrUnknownUser,
+		ErrNotFound,
+		ErrBadUserID,
+		ErrMessagesChatNotExist,
+		ErrMessagesContactNotFound:
+		return true
+	default:
+		return false
+	}
+}
+
+// TemporaryError reports whether err is an API error which could be retried
+// later.
+func TemporaryError(err error) bool {
+	code, ok := ErrorCodeOf(err)
+	return ok && code.Temporary()
+}
+
+// FloodError reports whether err is an API error caused by too frequent
+// calls.
+func FloodError(err error) bool {
+	code, ok := ErrorCodeOf(err)
+	return ok && code.Flood()
+}
+
+// AuthError reports whether err is an API error caused by invalid or expired
+// authorization.
+func AuthError(err error) bool {
+	code, ok := ErrorCodeOf(err)
+	return ok && code.Auth()
+}
+
+// PermissionError reports whether err is an API error caused by lack of
+// access rights.
+func PermissionError(err error) bool {
+	code, ok := ErrorCodeOf(err)
+	return ok && code.Permission()
+}
+
+// NotFoundError reports whether err is an API error caused by absence of
+// requested object.
+func NotFoundError(err error) bool {
+	code, ok := ErrorCodeOf(err)
+	return ok && code.NotFound()
+}
+
+// CaptchaError returns captcha parameters if err is an API error caused by
+// captcha requirement.
+func CaptchaError(err error) (sid, img string, ok bool) {
+	var vkErr *Error
+	if errors.As(err, &vkErr) {
+		return vkErr.CaptchaSID, vkErr.CaptchaImg, vkErr.CaptchaSID != ""
+	}
+	return "", "", false
+}
+
+// ErrorCodeOf returns code of API error found in err's chain.
+func ErrorCodeOf(err error) (code ErrorCode, ok bool) {
+	ok = errors.As(err, &code)
+	return code, ok
+}
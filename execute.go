@@ -0,0 +1,312 @@
+package vk
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/mailru/easyjson/jwriter"
+)
+
+// MaxBatchSize is a maximum number of API calls which could be made within
+// single execute call.
+const MaxBatchSize = 25
+
+// DefaultBatchDelay is a default time Batcher waits for batch to be filled up
+// before sending it.
+var DefaultBatchDelay = 50 * time.Millisecond
+
+// Batcher collects API calls and sends them as a single execute call.
+//
+// Calls with different access tokens are never mixed within one batch. Note
+// that call which context is canceled while waiting for results is still
+// sent with its batch; the batch request itself is canceled only when
+// contexts of all its calls are done.
+type Batcher struct {
+	// Client is used to make execute calls. If nil, DefaultClient is used.
+	Client *Client
+
+	// Options are applied to every execute call.
+	Options []QueryOption
+
+	// Limiter limits rate of execute calls. If nil, Client's limiter is
+	// used. If it is nil too, DefaultLimiter() is used.
+	Limiter *rate.Limiter
+
+	// Size is a maximum number of calls within a batch. If zero or greater
+	// than MaxBatchSize, MaxBatchSize is used.
+	Size int
+
+	// Delay is a maximum time to wait for batch to be filled up. If zero,
+	// DefaultBatchDelay is used.
+	Delay time.Duration
+
+	once    sync.Once
+	limiter *rate.Limiter
+
+	mu      sync.Mutex
+	batches map[string]*batch
+}
+
+type batch struct {
+	token string
+	calls []*batchCall
+	timer *time.Timer
+}
+
+type batchCall struct {
+	ctx    context.Context
+	method string
+	query  url.Values
+
+	done chan struct{}
+	bts  []byte
+	err  error
+}
+
+// Call queues call of given method and waits for its result. Returned bytes
+// are the call's response body, that is, the same as StripResponse() returns.
+func (b *Batcher) Call(ctx context.Context, method string, options ...QueryOption) ([]byte, error) {
+	b.init()
+
+	query := make(url.Values)
+	WithOptions(options)(query)
+
+	if query.Get("captcha_sid") != "" {
+		// Captcha answer relates to the whole execute call, thus we could not
+		// send it within a batch.
+		return b.call(ctx, method, WithQuery(query))
+	}
+
+	token := query.Get("access_token")
+	query.Del("access_token")
+
+	call := &batchCall{
+		ctx:    ctx,
+		method: method,
+		query:  query,
+		done:   make(chan struct{}),
+	}
+	b.enqueue(token, call)
+
+	select {
+	case <-call.done:
+		return call.bts, call.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (b *Batcher) init() {
+	b.once.Do(func() {
+		b.limiter = b.Limiter
+		if b.limiter == nil {
+			b.limiter = clientOrDefault(b.Client).Limiter
+		}
+		if b.limiter == nil {
+			b.limiter = DefaultLimiter()
+		}
+	})
+}
+
+func (b *Batcher) enqueue(token string, call *batchCall) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.batches == nil {
+		b.batches = make(map[string]*batch)
+	}
+	x := b.batches[token]
+	if x == nil {
+		x = &batch{token: token}
+		x.timer = time.AfterFunc(b.delay(), func() {
+			b.flush(x)
+		})
+		b.batches[token] = x
+	}
+	x.calls = append(x.calls, call)
+	if len(x.calls) < b.size() {
+		return
+	}
+	x.timer.Stop()
+	delete(b.batches, token)
+
+	go b.exec(x)
+}
+
+func (b *Batcher) flush(x *batch) {
+	b.mu.Lock()
+	if b.batches[x.token] != x {
+		// Batch was already sent due to its size.
+		b.mu.Unlock()
+		return
+	}
+	delete(b.batches, x.token)
+	b.mu.Unlock()
+
+	b.exec(x)
+}
+
+func (b *Batcher) exec(x *batch) {
+	ctx, cancel := batchContext(x.calls)
+	defer cancel()
+
+	var options []QueryOption
+	if x.token != "" {
+		options = append(options, WithParam("access_token", x.token))
+	}
+
+	if len(x.calls) == 1 {
+		// No need to wrap single call into execute.
+		call := x.calls[0]
+		call.bts, call.err = b.call(ctx, call.method,
+			WithOptions(options),
+			WithQuery(call.query),
+		)
+		close(call.done)
+		return
+	}
+
+	bts, err := b.request(ctx, "execute",
+		WithOptions(options),
+		WithParam("code", executeCode(x.calls)),
+	)
+	var resp executeResponse
+	if err == nil {
+		err = resp.UnmarshalJSON(bts)
+	}
+	if err == nil && resp.Err.Code != 0 {
+		err = &resp.Err
+	}
+	if err == nil && len(resp.Response) != len(x.calls) {
+		err = fmt.Errorf(
+			"execute returned %d results for %d calls",
+			len(resp.Response), len(x.calls),
+		)
+	}
+	if err != nil {
+		for _, call := range x.calls {
+			call.err = err
+			close(call.done)
+		}
+		return
+	}
+
+	// Failed calls have false results, but execute does not report indexes
+	// of failed calls. Thus errors are matched to the false results by
+	// method and by order among calls of that method. That is, a method
+	// which legitimately returns false could only steal an error of the same
+	// method, and only if the same batch has failed calls of it.
+	errs := make(map[string][]ExecuteError)
+	for _, e := range resp.ExecuteErrors {
+		errs[e.Method] = append(errs[e.Method], e)
+	}
+	for i, call := range x.calls {
+		result := []byte(resp.Response[i])
+		if es := errs[call.method]; string(result) == "false" && len(es) > 0 {
+			call.err = es[0].Err()
+			errs[call.method] = es[1:]
+		} else {
+			call.bts = result
+		}
+		close(call.done)
+	}
+}
+
+// batchContext returns context which is canceled when contexts of all given
+// calls are done. The returned cancel function must be called when batch
+// is complete.
+func batchContext(calls []*batchCall) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var (
+		n     = int32(len(calls))
+		stops = make([]func() bool, len(calls))
+	)
+	for i, call := range calls {
+		stops[i] = context.AfterFunc(call.ctx, func() {
+			if atomic.AddInt32(&n, -1) == 0 {
+				cancel()
+			}
+		})
+	}
+	return ctx, func() {
+		for _, stop := range stops {
+			stop()
+		}
+		cancel()
+	}
+}
+
+// call makes a single API call and returns stripped response.
+func (b *Batcher) call(ctx context.Context, method string, options ...QueryOption) ([]byte, error) {
+	bts, err := b.request(ctx, method, options...)
+	if err != nil {
+		return nil, err
+	}
+	return StripResponse(bts)
+}
+
+func (b *Batcher) request(ctx context.Context, method string, options ...QueryOption) ([]byte, error) {
+	if err := b.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return clientOrDefault(b.Client).Request(ctx, method,
+		WithOptions(b.Options),
+		WithOptions(options),
+	)
+}
+
+func (b *Batcher) size() int {
+	if n := b.Size; n > 0 && n < MaxBatchSize {
+		return n
+	}
+	return MaxBatchSize
+}
+
+func (b *Batcher) delay() time.Duration {
+	if d := b.Delay; d > 0 {
+		return d
+	}
+	return DefaultBatchDelay
+}
+
+// executeCode compiles given calls into VKScript code which returns an array
+// of calls results.
+func executeCode(calls []*batchCall) string {
+	var w jwriter.Writer
+	w.RawString("return [")
+	for i, call := range calls {
+		if i > 0 {
+			w.RawByte(',')
+		}
+		w.RawString("API.")
+		w.RawString(call.method)
+		w.RawString("({")
+
+		keys := make([]string, 0, len(call.query))
+		for key := range call.query {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for j, key := range keys {
+			if j > 0 {
+				w.RawByte(',')
+			}
+			w.String(key)
+			w.RawByte(':')
+			w.String(strings.Join(call.query[key], ","))
+		}
+		w.RawString("})")
+	}
+	w.RawString("];")
+
+	return string(w.Buffer.BuildBytes())
+}
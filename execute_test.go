@@ -0,0 +1,155 @@
+package vk_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/gobwas/vk"
+	"github.com/gobwas/vk/vktest"
+)
+
+type batchCall struct {
+	method  string
+	options []vk.QueryOption
+	err     error
+}
+
+// callBatch makes given calls concurrently with b and checks their errors.
+func callBatch(t *testing.T, b *vk.Batcher, calls []batchCall) {
+	var wg sync.WaitGroup
+	for _, call := range calls {
+		call := call
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := b.Call(context.Background(), call.method, call.options...)
+			if !errors.Is(err, call.err) {
+				t.Errorf(
+					"unexpected error of %s call: %v; want %v",
+					call.method, err, call.err,
+				)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestBatcherErrors(t *testing.T) {
+	srv := vktest.NewServer(&vktest.State{
+		Users:   []vk.User{{ID: 1}, {ID: 2}},
+		Friends: map[int][]int{1: {2}, 2: {1}},
+	})
+	defer srv.Close()
+
+	token := vk.WithAccessToken(srv.Token(1))
+	b := vk.Batcher{
+		Client:  srv.Client(),
+		Limiter: rate.NewLimiter(rate.Inf, 1),
+		Size:    5,
+		Delay:   time.Second,
+	}
+	callBatch(t, &b, []batchCall{
+		{
+			method:  "friends.delete",
+			options: vk.QueryOptions(token, vk.WithNumber("user_id", 42)),
+			err:     vk.ErrNotFound,
+		},
+		{
+			method:  "users.get",
+			options: vk.QueryOptions(token),
+		},
+		{
+			method:  "wall.delete",
+			options: vk.QueryOptions(token, vk.WithNumber("post_id", 1)),
+			err:     vk.ErrNotFound,
+		},
+		{
+			method:  "friends.delete",
+			options: vk.QueryOptions(token, vk.WithNumber("user_id", 2)),
+		},
+		{
+			method:  "friends.get",
+			options: vk.QueryOptions(token),
+		},
+	})
+	if calls := srv.Calls(); calls[0] != "execute" || len(calls) != 6 {
+		t.Errorf("unexpected server calls: %q; want single execute", calls)
+	}
+}
+
+func TestBatcherLegitimateFalse(t *testing.T) {
+	// Execute response where the first call returned false without an error.
+	const body = `{"response":[false,false,1],"execute_errors":[` +
+		`{"method":"wall.delete","error_code":15,"error_msg":"Access denied"}]}`
+
+	client := vktest.NewClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(strings.NewReader(body)),
+			Request:    req,
+		}, nil
+	}))
+	var (
+		b = vk.Batcher{
+			Client:  client,
+			Limiter: rate.NewLimiter(rate.Inf, 1),
+			Size:    3,
+			Delay:   time.Second,
+		}
+		wg    sync.WaitGroup
+		calls = []string{"groups.isMember", "wall.delete", "likes.add"}
+		errs  = make([]error, len(calls))
+	)
+	// Calls are made one by one to get predictable order within batch.
+	for i, method := range calls {
+		wg.Add(1)
+		go func(i int, method string) {
+			defer wg.Done()
+			_, errs[i] = b.Call(context.Background(), method)
+		}(i, method)
+		time.Sleep(10 * time.Millisecond)
+	}
+	wg.Wait()
+
+	for i, exp := range []error{nil, vk.ErrAccessDenied, nil} {
+		if !errors.Is(errs[i], exp) {
+			t.Errorf("unexpected error of %s call: %v; want %v", calls[i], errs[i], exp)
+		}
+	}
+}
+
+func TestBatcherContext(t *testing.T) {
+	var (
+		started  = make(chan struct{})
+		canceled = make(chan struct{})
+	)
+	client := vktest.NewClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		close(started)
+		<-req.Context().Done()
+		close(canceled)
+		return nil, req.Context().Err()
+	}))
+	b := vk.Batcher{
+		Client:  client,
+		Limiter: rate.NewLimiter(rate.Inf, 1),
+		Size:    1,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	go b.Call(ctx, "users.get")
+
+	<-started
+	cancel()
+	select {
+	case <-canceled:
+	case <-time.After(time.Second):
+		t.Fatalf("batch request was not canceled")
+	}
+}
@@ -0,0 +1,110 @@
+package vk
+
+import (
+	"sort"
+
+	"github.com/mailru/easyjson"
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+// Extra holds JSON fields which are unknown to the model type. Types which
+// have Extra field keep such fields on unmarshaling and write them back on
+// marshaling, so no data is lost while passing objects through.
+type Extra map[string]easyjson.RawMessage
+
+// Has reports whether e contains field with given key.
+func (e Extra) Has(key string) bool {
+	_, ok := e[key]
+	return ok
+}
+
+// Decode decodes field with given key into v. It is a no-op if there is no
+// such field.
+func (e Extra) Decode(key string, v easyjson.Unmarshaler) error {
+	raw, ok := e[key]
+	if !ok {
+		return nil
+	}
+	return easyjson.Unmarshal(raw, v)
+}
+
+// UnmarshalUnknown implements easyjson.UnknownsUnmarshaler.
+func (e *Extra) UnmarshalUnknown(in *jlexer.Lexer, key string) {
+	if *e == nil {
+		*e = make(Extra, 1)
+	}
+	// Raw() returns slice of the lexer's data which could be reused.
+	raw := in.Raw()
+	(*e)[key] = append(easyjson.RawMessage(nil), raw...)
+}
+
+// MarshalUnknowns implements easyjson.UnknownsMarshaler. Fields are written
+// in sorted order.
+func (e Extra) MarshalUnknowns(out *jwriter.Writer, first bool) {
+	keys := make([]string, 0, len(e))
+	for key := range e {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if !first {
+			out.RawByte(',')
+		}
+		first = false
+		out.String(key)
+		out.RawByte(':')
+		out.Raw(e[key], nil)
+	}
+}
+
+// Model types below keep unknown fields in Extra. Methods are promoted
+// manually because easyjson does not support embedded non-struct fields.
+
+func (p *Post) UnmarshalUnknown(in *jlexer.Lexer, key string) {
+	p.Extra.UnmarshalUnknown(in, key)
+}
+
+func (p *Post) MarshalUnknowns(out *jwriter.Writer, first bool) {
+	p.Extra.MarshalUnknowns(out, first)
+}
+
+func (m *Message) UnmarshalUnknown(in *jlexer.Lexer, key string) {
+	m.Extra.UnmarshalUnknown(in, key)
+}
+
+func (m *Message) MarshalUnknowns(out *jwriter.Writer, first bool) {
+	m.Extra.MarshalUnknowns(out, first)
+}
+
+func (a *Attachment) UnmarshalUnknown(in *jlexer.Lexer, key string) {
+	a.Extra.UnmarshalUnknown(in, key)
+}
+
+func (a *Attachment) MarshalUnknowns(out *jwriter.Writer, first bool) {
+	a.Extra.MarshalUnknowns(out, first)
+}
+
+func (p *Photo) UnmarshalUnknown(in *jlexer.Lexer, key string) {
+	p.Extra.UnmarshalUnknown(in, key)
+}
+
+func (p *Photo) MarshalUnknowns(out *jwriter.Writer, first bool) {
+	p.Extra.MarshalUnknowns(out, first)
+}
+
+func (v *Video) UnmarshalUnknown(in *jlexer.Lexer, key string) {
+	v.Extra.UnmarshalUnknown(in, key)
+}
+
+func (v *Video) MarshalUnknowns(out *jwriter.Writer, first bool) {
+	v.Extra.MarshalUnknowns(out, first)
+}
+
+func (u *User) UnmarshalUnknown(in *jlexer.Lexer, key string) {
+	u.Extra.UnmarshalUnknown(in, key)
+}
+
+func (u *User) MarshalUnknowns(out *jwriter.Writer, first bool) {
+	u.Extra.MarshalUnknowns(out, first)
+}
@@ -0,0 +1,150 @@
+package vk_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mailru/easyjson"
+
+	"github.com/gobwas/vk"
+)
+
+func TestExtraRoundTrip(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		in     string
+		object interface {
+			easyjson.Marshaler
+			easyjson.Unmarshaler
+		}
+		// unknown is a list of unknown top-level keys in sorted order.
+		unknown []string
+		// check checks that nested unknown fields are decoded.
+		check func(*testing.T, interface{})
+	}{
+		{
+			name: "post",
+			in: `{
+				"id": 1,
+				"zeta": 1,
+				"alpha": {"x": [1, 2], "y": null},
+				"beta": [1, "a", {"b": true}],
+				"attachments": [{
+					"type": "photo",
+					"photo": {"id": 5, "new_field": "v"},
+					"attachment_extra": true
+				}]
+			}`,
+			object:  new(vk.Post),
+			unknown: []string{"alpha", "beta", "zeta"},
+			check: func(t *testing.T, v interface{}) {
+				post := v.(*vk.Post)
+				if !post.Extra.Has("zeta") {
+					t.Errorf("post extra is not decoded: %v", post.Extra)
+				}
+				a := post.Attachments[0]
+				if !a.Extra.Has("attachment_extra") {
+					t.Errorf("attachment extra is not decoded: %v", a.Extra)
+				}
+				if a.Photo == nil || !a.Photo.Extra.Has("new_field") {
+					t.Errorf("photo extra is not decoded: %+v", a.Photo)
+				}
+			},
+		},
+		{
+			name: "message",
+			in: `{
+				"id": 1,
+				"payload": "{\"button\":1}",
+				"keyboard": {"buttons": [[{"action": {"type": "text"}}]]},
+				"conversation_message_id": 42,
+				"fwd_messages": [{"id": 2, "ref": "x"}]
+			}`,
+			object:  new(vk.Message),
+			unknown: []string{"conversation_message_id", "keyboard", "payload"},
+			check: func(t *testing.T, v interface{}) {
+				msg := v.(*vk.Message)
+				if fwd := msg.FwdMessages; len(fwd) != 1 || !fwd[0].Extra.Has("ref") {
+					t.Errorf("forwarded message extra is not decoded: %+v", fwd)
+				}
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if err := easyjson.Unmarshal([]byte(test.in), test.object); err != nil {
+				t.Fatal(err)
+			}
+			test.check(t, test.object)
+
+			out, err := easyjson.Marshal(test.object)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var exp, act map[string]interface{}
+			if err := json.Unmarshal([]byte(test.in), &exp); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal(out, &act); err != nil {
+				t.Fatalf("bad output %s: %v", out, err)
+			}
+			for key, v := range exp {
+				if !reflect.DeepEqual(act[key], v) && isUnknown(test.unknown, key) {
+					t.Errorf("unknown field %q is %v; want %v", key, act[key], v)
+				}
+			}
+			// Check nested fields which are unknown to the models.
+			if !reflect.DeepEqual(nested(act), nested(exp)) {
+				t.Errorf("nested unknown fields are lost:\n%s\nwant:\n%s", out, test.in)
+			}
+
+			prev := -1
+			for _, key := range test.unknown {
+				i := strings.Index(string(out), `"`+key+`":`)
+				if i < prev {
+					t.Errorf("unknown field %q is not written in sorted order: %s", key, out)
+				}
+				prev = i
+			}
+		})
+	}
+}
+
+func isUnknown(keys []string, key string) bool {
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// nested returns fields of objects nested into attachments and forwarded
+// messages which are unknown to the models.
+func nested(obj map[string]interface{}) (ret []interface{}) {
+	field := func(v interface{}, path ...string) interface{} {
+		for _, key := range path {
+			m, ok := v.(map[string]interface{})
+			if !ok {
+				return nil
+			}
+			v = m[key]
+		}
+		return v
+	}
+	if list, ok := obj["attachments"].([]interface{}); ok {
+		for _, a := range list {
+			ret = append(ret,
+				field(a, "attachment_extra"),
+				field(a, "photo", "new_field"),
+			)
+		}
+	}
+	if list, ok := obj["fwd_messages"].([]interface{}); ok {
+		for _, m := range list {
+			ret = append(ret, field(m, "ref"))
+		}
+	}
+	return ret
+}
@@ -17,22 +17,25 @@ type User struct {
 	Photo50                string       `json:"photo_50"`
 	Photo100               string       `json:"photo_100"`
 	Photo200Orig           string       `json:"photo_200_orig"`
-	HasMobile              int          `json:"has_mobile"`
+	HasMobile              BoolInt      `json:"has_mobile"`
 	Contacts               Contact      `json:"contacts"`
 	Education              Education    `json:"education"`
-	Online                 int          `json:"online"`
+	Online                 BoolInt      `json:"online"`
 	Relation               int          `json:"relation"`
 	LastSeen               LastSeen     `json:"last_seen"`
 	Status                 string       `json:"status"`
-	CanWritePrivateMessage int          `json:"can_write_private_message"`
-	CanSeeAllPosts         int          `json:"can_see_all_posts"`
-	CanPost                int          `json:"can_post"`
+	CanWritePrivateMessage BoolInt      `json:"can_write_private_message"`
+	CanSeeAllPosts         BoolInt      `json:"can_see_all_posts"`
+	CanPost                BoolInt      `json:"can_post"`
 	Universities           []University `json:"universities"`
+
+	// Extra holds fields unknown to User.
+	Extra Extra `json:"-"`
 }
 
 type LastSeen struct {
-	Time     int `json:"time"`
-	Platform int `json:"platform"`
+	Time     UnixTime `json:"time"`
+	Platform int      `json:"platform"`
 }
 
 type University struct {
@@ -50,16 +53,11 @@ type University struct {
 }
 
 type Education struct {
-	University     int    `json:"university"`
-	UniversityName string `json:"university_name"`
-	Faculty        int    `json:"faculty"`
-	FacultyName    string `json:"faculty_name"`
-	Graduation     int    `json:"graduation"`
-}
-
-type Country struct {
-	ID    int    `json:"id"`
-	Title string `json:"title"`
+	University     IntOrString `json:"university"`
+	UniversityName string      `json:"university_name"`
+	Faculty        IntOrString `json:"faculty"`
+	FacultyName    string      `json:"faculty_name"`
+	Graduation     IntOrString `json:"graduation"`
 }
 
 type Contact struct {
@@ -71,8 +69,3 @@ type Users struct {
 	Count int    `json:"count"`
 	Items []User `json:"items"`
 }
-
-type City struct {
-	ID    int    `json:"id"`
-	Title string `json:"title"`
-}
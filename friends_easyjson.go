@@ -180,13 +180,13 @@ func easyjson3994edd1DecodeGithubComGobwasVk1(in *jlexer.Lexer, out *User) {
 		case "photo_200_orig":
 			out.Photo200Orig = string(in.String())
 		case "has_mobile":
-			out.HasMobile = int(in.Int())
+			(out.HasMobile).UnmarshalEasyJSON(in)
 		case "contacts":
 			(out.Contacts).UnmarshalEasyJSON(in)
 		case "education":
 			(out.Education).UnmarshalEasyJSON(in)
 		case "online":
-			out.Online = int(in.Int())
+			(out.Online).UnmarshalEasyJSON(in)
 		case "relation":
 			out.Relation = int(in.Int())
 		case "last_seen":
@@ -194,11 +194,11 @@ func easyjson3994edd1DecodeGithubComGobwasVk1(in *jlexer.Lexer, out *User) {
 		case "status":
 			out.Status = string(in.String())
 		case "can_write_private_message":
-			out.CanWritePrivateMessage = int(in.Int())
+			(out.CanWritePrivateMessage).UnmarshalEasyJSON(in)
 		case "can_see_all_posts":
-			out.CanSeeAllPosts = int(in.Int())
+			(out.CanSeeAllPosts).UnmarshalEasyJSON(in)
 		case "can_post":
-			out.CanPost = int(in.Int())
+			(out.CanPost).UnmarshalEasyJSON(in)
 		case "universities":
 			if in.IsNull() {
 				in.Skip()
@@ -223,7 +223,7 @@ func easyjson3994edd1DecodeGithubComGobwasVk1(in *jlexer.Lexer, out *User) {
 				in.Delim(']')
 			}
 		default:
-			in.SkipRecursive()
+			out.UnmarshalUnknown(in, key)
 		}
 		in.WantComma()
 	}
@@ -384,7 +384,7 @@ func easyjson3994edd1EncodeGithubComGobwasVk1(out *jwriter.Writer, in User) {
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.HasMobile))
+		(in.HasMobile).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"contacts\":"
@@ -414,7 +414,7 @@ func easyjson3994edd1EncodeGithubComGobwasVk1(out *jwriter.Writer, in User) {
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.Online))
+		(in.Online).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"relation\":"
@@ -454,7 +454,7 @@ func easyjson3994edd1EncodeGithubComGobwasVk1(out *jwriter.Writer, in User) {
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.CanWritePrivateMessage))
+		(in.CanWritePrivateMessage).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"can_see_all_posts\":"
@@ -464,7 +464,7 @@ func easyjson3994edd1EncodeGithubComGobwasVk1(out *jwriter.Writer, in User) {
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.CanSeeAllPosts))
+		(in.CanSeeAllPosts).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"can_post\":"
@@ -474,7 +474,7 @@ func easyjson3994edd1EncodeGithubComGobwasVk1(out *jwriter.Writer, in User) {
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.CanPost))
+		(in.CanPost).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"universities\":"
@@ -497,6 +497,7 @@ func easyjson3994edd1EncodeGithubComGobwasVk1(out *jwriter.Writer, in User) {
 			out.RawByte(']')
 		}
 	}
+	in.MarshalUnknowns(out, false)
 	out.RawByte('}')
 }
 
@@ -734,7 +735,7 @@ func easyjson3994edd1DecodeGithubComGobwasVk3(in *jlexer.Lexer, out *LastSeen) {
 		}
 		switch key {
 		case "time":
-			out.Time = int(in.Int())
+			(out.Time).UnmarshalEasyJSON(in)
 		case "platform":
 			out.Platform = int(in.Int())
 		default:
@@ -759,7 +760,7 @@ func easyjson3994edd1EncodeGithubComGobwasVk3(out *jwriter.Writer, in LastSeen)
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.Time))
+		(in.Time).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"platform\":"
@@ -817,15 +818,15 @@ func easyjson3994edd1DecodeGithubComGobwasVk4(in *jlexer.Lexer, out *Education)
 		}
 		switch key {
 		case "university":
-			out.University = int(in.Int())
+			(out.University).UnmarshalEasyJSON(in)
 		case "university_name":
 			out.UniversityName = string(in.String())
 		case "faculty":
-			out.Faculty = int(in.Int())
+			(out.Faculty).UnmarshalEasyJSON(in)
 		case "faculty_name":
 			out.FacultyName = string(in.String())
 		case "graduation":
-			out.Graduation = int(in.Int())
+			(out.Graduation).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -848,7 +849,7 @@ func easyjson3994edd1EncodeGithubComGobwasVk4(out *jwriter.Writer, in Education)
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.University))
+		(in.University).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"university_name\":"
@@ -868,7 +869,7 @@ func easyjson3994edd1EncodeGithubComGobwasVk4(out *jwriter.Writer, in Education)
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.Faculty))
+		(in.Faculty).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"faculty_name\":"
@@ -888,7 +889,7 @@ func easyjson3994edd1EncodeGithubComGobwasVk4(out *jwriter.Writer, in Education)
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.Graduation))
+		(in.Graduation).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
@@ -916,90 +917,7 @@ func (v *Education) UnmarshalJSON(data []byte) error {
 func (v *Education) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson3994edd1DecodeGithubComGobwasVk4(l, v)
 }
-func easyjson3994edd1DecodeGithubComGobwasVk5(in *jlexer.Lexer, out *Country) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeString()
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "id":
-			out.ID = int(in.Int())
-		case "title":
-			out.Title = string(in.String())
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjson3994edd1EncodeGithubComGobwasVk5(out *jwriter.Writer, in Country) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"id\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.Int(int(in.ID))
-	}
-	{
-		const prefix string = ",\"title\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.Title))
-	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v Country) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson3994edd1EncodeGithubComGobwasVk5(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Country) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson3994edd1EncodeGithubComGobwasVk5(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *Country) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson3994edd1DecodeGithubComGobwasVk5(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Country) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson3994edd1DecodeGithubComGobwasVk5(l, v)
-}
-func easyjson3994edd1DecodeGithubComGobwasVk6(in *jlexer.Lexer, out *Contact) {
+func easyjson3994edd1DecodeGithubComGobwasVk5(in *jlexer.Lexer, out *Contact) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1032,7 +950,7 @@ func easyjson3994edd1DecodeGithubComGobwasVk6(in *jlexer.Lexer, out *Contact) {
 		in.Consumed()
 	}
 }
-func easyjson3994edd1EncodeGithubComGobwasVk6(out *jwriter.Writer, in Contact) {
+func easyjson3994edd1EncodeGithubComGobwasVk5(out *jwriter.Writer, in Contact) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1062,106 +980,23 @@ func easyjson3994edd1EncodeGithubComGobwasVk6(out *jwriter.Writer, in Contact) {
 // MarshalJSON supports json.Marshaler interface
 func (v Contact) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson3994edd1EncodeGithubComGobwasVk6(&w, v)
+	easyjson3994edd1EncodeGithubComGobwasVk5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Contact) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson3994edd1EncodeGithubComGobwasVk6(w, v)
+	easyjson3994edd1EncodeGithubComGobwasVk5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Contact) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson3994edd1DecodeGithubComGobwasVk6(&r, v)
+	easyjson3994edd1DecodeGithubComGobwasVk5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Contact) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson3994edd1DecodeGithubComGobwasVk6(l, v)
-}
-func easyjson3994edd1DecodeGithubComGobwasVk7(in *jlexer.Lexer, out *City) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeString()
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "id":
-			out.ID = int(in.Int())
-		case "title":
-			out.Title = string(in.String())
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjson3994edd1EncodeGithubComGobwasVk7(out *jwriter.Writer, in City) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"id\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.Int(int(in.ID))
-	}
-	{
-		const prefix string = ",\"title\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.Title))
-	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v City) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson3994edd1EncodeGithubComGobwasVk7(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v City) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson3994edd1EncodeGithubComGobwasVk7(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *City) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson3994edd1DecodeGithubComGobwasVk7(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *City) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson3994edd1DecodeGithubComGobwasVk7(l, v)
+	easyjson3994edd1DecodeGithubComGobwasVk5(l, v)
 }
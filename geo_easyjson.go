@@ -45,9 +45,13 @@ func easyjsonA0535929DecodeGithubComGobwasVk(in *jlexer.Lexer, out *GeoPlace) {
 		case "longitude":
 			out.Longitude = float64(in.Float64())
 		case "created":
-			out.Created = int(in.Int())
+			(out.Created).UnmarshalEasyJSON(in)
 		case "icon":
 			out.Icon = string(in.String())
+		case "country":
+			(out.Country).UnmarshalEasyJSON(in)
+		case "city":
+			(out.City).UnmarshalEasyJSON(in)
 		case "type":
 			out.Type = int(in.Int())
 		case "group_id":
@@ -57,7 +61,7 @@ func easyjsonA0535929DecodeGithubComGobwasVk(in *jlexer.Lexer, out *GeoPlace) {
 		case "checkins":
 			out.Checkins = int(in.Int())
 		case "updated":
-			out.Updated = int(in.Int())
+			(out.Updated).UnmarshalEasyJSON(in)
 		case "address":
 			out.Address = string(in.String())
 		default:
@@ -122,7 +126,7 @@ func easyjsonA0535929EncodeGithubComGobwasVk(out *jwriter.Writer, in GeoPlace) {
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.Created))
+		(in.Created).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"icon\":"
@@ -134,6 +138,26 @@ func easyjsonA0535929EncodeGithubComGobwasVk(out *jwriter.Writer, in GeoPlace) {
 		}
 		out.String(string(in.Icon))
 	}
+	{
+		const prefix string = ",\"country\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(in.Country).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"city\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(in.City).MarshalEasyJSON(out)
+	}
 	{
 		const prefix string = ",\"type\":"
 		if first {
@@ -182,7 +206,7 @@ func easyjsonA0535929EncodeGithubComGobwasVk(out *jwriter.Writer, in GeoPlace) {
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.Updated))
+		(in.Updated).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"address\":"
@@ -242,7 +266,7 @@ func easyjsonA0535929DecodeGithubComGobwasVk1(in *jlexer.Lexer, out *Geo) {
 		case "type":
 			out.Type = string(in.String())
 		case "coordinates":
-			out.Coordinates = string(in.String())
+			(out.Coordinates).UnmarshalEasyJSON(in)
 		case "place":
 			(out.Place).UnmarshalEasyJSON(in)
 		default:
@@ -277,7 +301,7 @@ func easyjsonA0535929EncodeGithubComGobwasVk1(out *jwriter.Writer, in Geo) {
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.Coordinates))
+		(in.Coordinates).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"place\":"
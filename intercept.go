@@ -0,0 +1,116 @@
+package vk
+
+import (
+	"context"
+	"log/slog"
+	"net/url"
+	"time"
+)
+
+// Call describes an API call passed through interceptors.
+type Call struct {
+	Method string
+	Params url.Values
+}
+
+func (c *Call) url(base string) (*url.URL, error) {
+	u, err := url.Parse(base)
+	if err != nil {
+		return nil, err
+	}
+	u.Path += "/" + c.Method
+	u.RawQuery = c.Params.Encode()
+	return u, nil
+}
+
+// Next makes the call passed to the Interceptor. It returns raw response
+// body.
+type Next func(ctx context.Context, call *Call) ([]byte, error)
+
+// Interceptor wraps API call. It could modify the call before passing it to
+// next, inspect the results or even not call next at all.
+type Interceptor func(ctx context.Context, call *Call, next Next) ([]byte, error)
+
+func chain(i Interceptor, next Next) Next {
+	return func(ctx context.Context, call *Call) ([]byte, error) {
+		return i(ctx, call, next)
+	}
+}
+
+// SensitiveParams lists parameters which values are hidden by RedactParams.
+var SensitiveParams = []string{
+	"access_token",
+	"client_secret",
+	"captcha_key",
+	"password",
+}
+
+// RedactParams returns copy of params with values of SensitiveParams
+// replaced by a placeholder.
+func RedactParams(params url.Values) url.Values {
+	ret := make(url.Values, len(params))
+	for key, values := range params {
+		ret[key] = values
+	}
+	for _, key := range SensitiveParams {
+		if _, has := ret[key]; has {
+			ret[key] = []string{"REDACTED"}
+		}
+	}
+	return ret
+}
+
+// RedactCall returns Interceptor which passes to inner a copy of the call with
+// redacted params. The original call is passed further down the chain, so
+// only inner sees the redacted values.
+//
+// Typical usage is:
+//
+//	client := vk.Client{
+//		Interceptors: []vk.Interceptor{
+//			vk.RedactCall(vk.LogCalls(logger)),
+//		},
+//	}
+func RedactCall(inner Interceptor) Interceptor {
+	return func(ctx context.Context, call *Call, next Next) ([]byte, error) {
+		redacted := &Call{
+			Method: call.Method,
+			Params: RedactParams(call.Params),
+		}
+		return inner(ctx, redacted, func(ctx context.Context, _ *Call) ([]byte, error) {
+			return next(ctx, call)
+		})
+	}
+}
+
+// LogCalls returns Interceptor which logs method, latency and error of every
+// call with given logger. Params are logged as is, so it should be wrapped
+// with RedactCall() if params contain secrets.
+func LogCalls(logger *slog.Logger) Interceptor {
+	return func(ctx context.Context, call *Call, next Next) ([]byte, error) {
+		start := time.Now()
+		bts, err := next(ctx, call)
+		callErr := err
+		if callErr == nil {
+			// API errors are not returned by transport, thus we need to
+			// look into the response body.
+			_, callErr = StripResponse(bts)
+		}
+		attrs := []slog.Attr{
+			slog.String("method", call.Method),
+			slog.Duration("latency", time.Since(start)),
+			slog.String("params", call.Params.Encode()),
+		}
+		level := slog.LevelDebug
+		if callErr != nil {
+			level = slog.LevelWarn
+			attrs = append(attrs, slog.String("error", callErr.Error()))
+		}
+		if code, ok := ErrorCodeOf(callErr); ok {
+			attrs = append(attrs, slog.Int("error_code", int(code)))
+		}
+		logger.LogAttrs(ctx, level, "vk call", attrs...)
+
+		return bts, err
+	}
+}
@@ -48,13 +48,23 @@ func Photo(ctx context.Context, destDir string, photo vk.Photo, size vk.PhotoSiz
 
 	filepath := filepath.Clean(fmt.Sprintf("%s/%s%s", destDir, photoID, ext))
 
+	return File(ctx, filepath, size.Src)
+}
+
+// Media downloads attachment file into destDir using m.Name() as file name.
+func Media(ctx context.Context, destDir string, m vk.Media) error {
+	return File(ctx, filepath.Join(destDir, m.Name()), m.URL)
+}
+
+// File downloads file from url u and saves it at filepath.
+func File(ctx context.Context, filepath, u string) error {
 	file, err := os.Create(filepath)
 	if err != nil {
 		return err
 	}
 	defer file.Close()
 
-	req, err := http.NewRequest("GET", size.Src, nil)
+	req, err := http.NewRequest("GET", u, nil)
 	if err != nil {
 		return err
 	}
@@ -5,12 +5,30 @@ import (
 	"net/http"
 )
 
+// StatusError is returned by CheckResponseStatus for non-200 responses.
+type StatusError struct {
+	Code   int
+	Status string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf(
+		"bad status code: %d %q",
+		e.Code, e.Status,
+	)
+}
+
+// Temporary reports whether the request could be retried later.
+func (e *StatusError) Temporary() bool {
+	return e.Code >= 500 || e.Code == http.StatusTooManyRequests
+}
+
 func CheckResponseStatus(resp *http.Response) error {
 	if resp.StatusCode == http.StatusOK {
 		return nil
 	}
-	return fmt.Errorf(
-		"bad status code: %d %q",
-		resp.StatusCode, resp.Status,
-	)
+	return &StatusError{
+		Code:   resp.StatusCode,
+		Status: resp.Status,
+	}
 }
@@ -0,0 +1,294 @@
+package vk
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+// This file contains types for the fields which VK returns in different
+// shapes depending on method, API version or phase of the moon. Decoding of
+// these types never fails on the shape drift.
+
+// City and Country are returned either as objects or as bare ids.
+type (
+	City    = IDOrObject
+	Country = IDOrObject
+)
+
+// IntOrString is a value which is returned either as a number or as a
+// string.
+type IntOrString string
+
+// Int returns v as an integer.
+func (v IntOrString) Int() (int, error) {
+	return strconv.Atoi(string(v))
+}
+
+func (v *IntOrString) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	*v = ""
+	if raw, ok := rawValue(in); ok {
+		*v = IntOrString(scalar(raw))
+	}
+}
+
+func (v IntOrString) MarshalEasyJSON(out *jwriter.Writer) {
+	if _, err := strconv.Atoi(string(v)); err == nil {
+		out.RawString(string(v))
+		return
+	}
+	out.String(string(v))
+}
+
+func (v *IntOrString) UnmarshalJSON(p []byte) error {
+	return unmarshal(p, v.UnmarshalEasyJSON)
+}
+
+func (v IntOrString) MarshalJSON() ([]byte, error) {
+	return marshal(v.MarshalEasyJSON)
+}
+
+// IDOrObject is an object with id and title which is sometimes returned as
+// a bare id.
+type IDOrObject struct {
+	ID    int    `json:"id"`
+	Title string `json:"title"`
+}
+
+func (v *IDOrObject) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	*v = IDOrObject{}
+	raw, ok := rawValue(in)
+	if !ok {
+		return
+	}
+	if raw[0] != '{' {
+		s := scalar(raw)
+		if id, err := strconv.Atoi(s); err == nil {
+			v.ID = id
+		} else if raw[0] == '"' {
+			v.Title = s
+		}
+		return
+	}
+	ok = object(raw, func(key string, val []byte) {
+		switch key {
+		case "id":
+			v.ID, _ = strconv.Atoi(scalar(val))
+		case "title", "name":
+			v.Title = scalar(val)
+		}
+	})
+	if !ok {
+		*v = IDOrObject{}
+	}
+}
+
+func (v IDOrObject) MarshalEasyJSON(out *jwriter.Writer) {
+	out.RawString(`{"id":`)
+	out.Int(v.ID)
+	out.RawString(`,"title":`)
+	out.String(v.Title)
+	out.RawByte('}')
+}
+
+func (v *IDOrObject) UnmarshalJSON(p []byte) error {
+	return unmarshal(p, v.UnmarshalEasyJSON)
+}
+
+func (v IDOrObject) MarshalJSON() ([]byte, error) {
+	return marshal(v.MarshalEasyJSON)
+}
+
+// BoolInt is a boolean flag which is returned as 0 or 1, as a boolean or
+// as a string.
+type BoolInt bool
+
+func (v *BoolInt) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	*v = false
+	raw, ok := rawValue(in)
+	if !ok {
+		return
+	}
+	switch s := scalar(raw); s {
+	case "true":
+		*v = true
+	default:
+		n, err := strconv.ParseFloat(s, 64)
+		*v = BoolInt(err == nil && n != 0)
+	}
+}
+
+func (v BoolInt) MarshalEasyJSON(out *jwriter.Writer) {
+	if v {
+		out.RawByte('1')
+	} else {
+		out.RawByte('0')
+	}
+}
+
+func (v *BoolInt) UnmarshalJSON(p []byte) error {
+	return unmarshal(p, v.UnmarshalEasyJSON)
+}
+
+func (v BoolInt) MarshalJSON() ([]byte, error) {
+	return marshal(v.MarshalEasyJSON)
+}
+
+// Coordinates is a geographical point which is returned as "lat lon" string
+// or as an object with latitude and longitude fields.
+type Coordinates struct {
+	Lat float64
+	Lon float64
+}
+
+func (c Coordinates) IsZero() bool {
+	return c.Lat == 0 && c.Lon == 0
+}
+
+func (c Coordinates) String() string {
+	return strconv.FormatFloat(c.Lat, 'f', -1, 64) + " " +
+		strconv.FormatFloat(c.Lon, 'f', -1, 64)
+}
+
+func (c *Coordinates) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	*c = Coordinates{}
+	raw, ok := rawValue(in)
+	if !ok {
+		return
+	}
+	switch raw[0] {
+	case '"':
+		fields := strings.Fields(scalar(raw))
+		if len(fields) != 2 {
+			return
+		}
+		c.Lat, _ = strconv.ParseFloat(fields[0], 64)
+		c.Lon, _ = strconv.ParseFloat(fields[1], 64)
+	case '{':
+		ok = object(raw, func(key string, val []byte) {
+			switch key {
+			case "latitude", "lat":
+				c.Lat, _ = strconv.ParseFloat(scalar(val), 64)
+			case "longitude", "lon", "long":
+				c.Lon, _ = strconv.ParseFloat(scalar(val), 64)
+			}
+		})
+		if !ok {
+			*c = Coordinates{}
+		}
+	}
+}
+
+func (c Coordinates) MarshalEasyJSON(out *jwriter.Writer) {
+	if c.IsZero() {
+		out.String("")
+		return
+	}
+	out.String(c.String())
+}
+
+func (c *Coordinates) UnmarshalJSON(p []byte) error {
+	return unmarshal(p, c.UnmarshalEasyJSON)
+}
+
+func (c Coordinates) MarshalJSON() ([]byte, error) {
+	return marshal(c.MarshalEasyJSON)
+}
+
+// UnixTime is a time which is returned as unix timestamp. Zero timestamp is
+// decoded as zero time.
+type UnixTime struct {
+	time.Time
+}
+
+func (t UnixTime) Unix() int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Time.Unix()
+}
+
+func (t *UnixTime) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	*t = UnixTime{}
+	raw, ok := rawValue(in)
+	if !ok {
+		return
+	}
+	sec, err := strconv.ParseFloat(scalar(raw), 64)
+	if err != nil || sec == 0 {
+		return
+	}
+	t.Time = time.Unix(int64(sec), 0)
+}
+
+func (t UnixTime) MarshalEasyJSON(out *jwriter.Writer) {
+	out.Int64(t.Unix())
+}
+
+func (t *UnixTime) UnmarshalJSON(p []byte) error {
+	return unmarshal(p, t.UnmarshalEasyJSON)
+}
+
+func (t UnixTime) MarshalJSON() ([]byte, error) {
+	return marshal(t.MarshalEasyJSON)
+}
+
+// rawValue reads next value. It returns false for null and empty values.
+func rawValue(in *jlexer.Lexer) ([]byte, bool) {
+	if in.IsNull() {
+		in.Skip()
+		return nil, false
+	}
+	raw := in.Raw()
+	return raw, in.Ok() && len(raw) > 0
+}
+
+// scalar returns raw string unquoted and raw number or boolean as is. It
+// returns empty string for objects, arrays and malformed strings.
+func scalar(raw []byte) string {
+	switch raw[0] {
+	case '{', '[':
+		return ""
+	case '"':
+		str := jlexer.Lexer{Data: raw}
+		s := str.String()
+		if str.Error() != nil {
+			return ""
+		}
+		return s
+	default:
+		return string(raw)
+	}
+}
+
+// object calls f for each non-null field of raw object. Fields which values
+// could not be read are skipped. It returns false if raw is malformed.
+func object(raw []byte, f func(key string, val []byte)) bool {
+	obj := jlexer.Lexer{Data: raw}
+	obj.Delim('{')
+	for obj.Ok() && !obj.IsDelim('}') {
+		key := obj.String()
+		obj.WantColon()
+		if val, ok := rawValue(&obj); ok {
+			f(key, val)
+		}
+		obj.WantComma()
+	}
+	obj.Delim('}')
+	return obj.Error() == nil
+}
+
+func unmarshal(p []byte, f func(*jlexer.Lexer)) error {
+	in := jlexer.Lexer{Data: p}
+	f(&in)
+	return in.Error()
+}
+
+func marshal(f func(*jwriter.Writer)) ([]byte, error) {
+	var out jwriter.Writer
+	f(&out)
+	return out.BuildBytes()
+}
@@ -0,0 +1,154 @@
+package vk_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gobwas/vk"
+)
+
+func TestIntOrString(t *testing.T) {
+	for _, test := range []struct {
+		in  string
+		exp vk.IntOrString
+	}{
+		{`42`, "42"},
+		{`"42"`, "42"},
+		{`"MSU"`, "MSU"},
+		{`null`, ""},
+		{`{"id":1}`, ""},
+		{`[1]`, ""},
+	} {
+		var v vk.IntOrString
+		if err := v.UnmarshalJSON([]byte(test.in)); err != nil {
+			t.Errorf("unmarshal %s: unexpected error: %v", test.in, err)
+		}
+		if v != test.exp {
+			t.Errorf("unmarshal %s: got %q; want %q", test.in, v, test.exp)
+		}
+	}
+}
+
+func TestIDOrObject(t *testing.T) {
+	for _, test := range []struct {
+		in  string
+		exp vk.IDOrObject
+	}{
+		{`1`, vk.IDOrObject{ID: 1}},
+		{`"1"`, vk.IDOrObject{ID: 1}},
+		{`"Moscow"`, vk.IDOrObject{Title: "Moscow"}},
+		{`null`, vk.IDOrObject{}},
+		{`[1]`, vk.IDOrObject{}},
+		{`{"id":1,"title":"Moscow"}`, vk.IDOrObject{ID: 1, Title: "Moscow"}},
+		{`{"id":"1","name":"Moscow"}`, vk.IDOrObject{ID: 1, Title: "Moscow"}},
+		{`{"id":1,"title":123}`, vk.IDOrObject{ID: 1, Title: "123"}},
+		{`{"id":{"x":1},"title":["Moscow"]}`, vk.IDOrObject{}},
+		{`{"id":1,"title":null,"area":{"id":2}}`, vk.IDOrObject{ID: 1}},
+	} {
+		var v vk.IDOrObject
+		if err := v.UnmarshalJSON([]byte(test.in)); err != nil {
+			t.Errorf("unmarshal %s: unexpected error: %v", test.in, err)
+		}
+		if v != test.exp {
+			t.Errorf("unmarshal %s: got %+v; want %+v", test.in, v, test.exp)
+		}
+	}
+}
+
+func TestBoolInt(t *testing.T) {
+	for _, test := range []struct {
+		in  string
+		exp vk.BoolInt
+	}{
+		{`1`, true},
+		{`2`, true},
+		{`0`, false},
+		{`true`, true},
+		{`false`, false},
+		{`"1"`, true},
+		{`"true"`, true},
+		{`"0"`, false},
+		{`""`, false},
+		{`"yes"`, false},
+		{`null`, false},
+		{`{"value":1}`, false},
+		{`[1]`, false},
+	} {
+		var v vk.BoolInt
+		if err := v.UnmarshalJSON([]byte(test.in)); err != nil {
+			t.Errorf("unmarshal %s: unexpected error: %v", test.in, err)
+		}
+		if v != test.exp {
+			t.Errorf("unmarshal %s: got %v; want %v", test.in, v, test.exp)
+		}
+	}
+}
+
+func TestCoordinates(t *testing.T) {
+	for _, test := range []struct {
+		in  string
+		exp vk.Coordinates
+	}{
+		{`"55.75 37.61"`, vk.Coordinates{Lat: 55.75, Lon: 37.61}},
+		{`"55.75"`, vk.Coordinates{}},
+		{`{"latitude":55.75,"longitude":37.61}`, vk.Coordinates{Lat: 55.75, Lon: 37.61}},
+		{`{"lat":"55.75","long":"37.61"}`, vk.Coordinates{Lat: 55.75, Lon: 37.61}},
+		{`{"latitude":55.75,"longitude":{"value":1}}`, vk.Coordinates{Lat: 55.75}},
+		{`null`, vk.Coordinates{}},
+		{`[55.75,37.61]`, vk.Coordinates{}},
+	} {
+		var v vk.Coordinates
+		if err := v.UnmarshalJSON([]byte(test.in)); err != nil {
+			t.Errorf("unmarshal %s: unexpected error: %v", test.in, err)
+		}
+		if v != test.exp {
+			t.Errorf("unmarshal %s: got %+v; want %+v", test.in, v, test.exp)
+		}
+	}
+}
+
+func TestUnixTime(t *testing.T) {
+	for _, test := range []struct {
+		in  string
+		exp int64
+	}{
+		{`1500000000`, 1500000000},
+		{`"1500000000"`, 1500000000},
+		{`1500000000.5`, 1500000000},
+		{`0`, 0},
+		{`null`, 0},
+		{`"yesterday"`, 0},
+		{`{"time":1}`, 0},
+	} {
+		var v vk.UnixTime
+		if err := v.UnmarshalJSON([]byte(test.in)); err != nil {
+			t.Errorf("unmarshal %s: unexpected error: %v", test.in, err)
+		}
+		if act := v.Unix(); act != test.exp {
+			t.Errorf("unmarshal %s: got %d; want %d", test.in, act, test.exp)
+		}
+		if test.exp == 0 && !v.IsZero() {
+			t.Errorf("unmarshal %s: got %s; want zero time", test.in, v.Format(time.RFC3339))
+		}
+	}
+}
+
+func TestLenientNested(t *testing.T) {
+	var post vk.Post
+	err := post.UnmarshalJSON([]byte(`{
+		"id": 1,
+		"geo": {
+			"coordinates": {"latitude": "1", "longitude": "2"},
+			"place": {"country": {"id": 1, "title": 123}, "created": "yesterday"}
+		}
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := (vk.Coordinates{Lat: 1, Lon: 2}); post.Geo.Coordinates != exp {
+		t.Errorf("unexpected coordinates: %+v; want %+v", post.Geo.Coordinates, exp)
+	}
+	if exp := (vk.IDOrObject{ID: 1, Title: "123"}); post.Geo.Place.Country != exp {
+		t.Errorf("unexpected country: %+v; want %+v", post.Geo.Place.Country, exp)
+	}
+}
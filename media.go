@@ -0,0 +1,128 @@
+package vk
+
+import (
+	"path"
+	"strconv"
+	"strings"
+)
+
+// Media describes downloadable file of an attachment.
+type Media struct {
+	Type    AttachmentType
+	OwnerID int
+	ID      int
+	URL     string
+
+	// Ext is a file extension like ".gif". If empty, it is taken from URL.
+	Ext string
+}
+
+// Name returns file name of the media like "photo1_2.jpg".
+func (m Media) Name() string {
+	ext := m.Ext
+	if ext == "" {
+		ext = path.Ext(m.URL)
+	}
+	if i := strings.IndexByte(ext, '?'); i != -1 {
+		ext = ext[:i]
+	}
+	return string(m.Type) +
+		strconv.Itoa(m.OwnerID) + "_" + strconv.Itoa(m.ID) +
+		ext
+}
+
+// Media returns downloadable file of the attachment. Videos, links and polls
+// have no files. Wall posts are not walked into; use Post.Media() for them.
+func (a *Attachment) Media() (m Media, ok bool) {
+	m.Type = a.Type
+	switch {
+	case a.Photo != nil && a.Type == AttachmentPhoto:
+		m.OwnerID, m.ID = a.Photo.OwnerID, a.Photo.ID
+		m.URL = largestSize(a.Photo.Sizes).Src
+	case a.Audio != nil && a.Type == AttachmentAudio:
+		m.OwnerID, m.ID = a.Audio.OwnerID, a.Audio.ID
+		m.URL = a.Audio.URL
+	case a.Doc != nil && a.Type == AttachmentDoc:
+		m.OwnerID, m.ID = a.Doc.OwnerID, a.Doc.ID
+		m.URL = a.Doc.URL
+		if a.Doc.Ext != "" {
+			m.Ext = "." + a.Doc.Ext
+		}
+	case a.Sticker != nil && a.Type == AttachmentSticker:
+		m.ID = a.Sticker.StickerID
+		m.URL = largestImage(a.Sticker.Images).URL
+	case a.Gift != nil && a.Type == AttachmentGift:
+		m.ID = a.Gift.ID
+		m.URL = a.Gift.Thumb256
+	case a.Graffiti != nil && a.Type == AttachmentGraffiti:
+		m.OwnerID, m.ID = a.Graffiti.OwnerID, a.Graffiti.ID
+		m.URL = a.Graffiti.URL
+	case a.AudioMessage != nil && a.Type == AttachmentAudioMessage:
+		m.OwnerID, m.ID = a.AudioMessage.OwnerID, a.AudioMessage.ID
+		m.URL = a.AudioMessage.LinkMP3
+	case a.Market != nil && a.Type == AttachmentMarket:
+		m.OwnerID, m.ID = a.Market.OwnerID, a.Market.ID
+		m.URL = a.Market.ThumbPhoto
+	}
+	return m, m.URL != ""
+}
+
+// Media returns downloadable files attached to the post and to the reposted
+// posts.
+func (p *Post) Media() []Media {
+	return appendPostMedia(nil, p)
+}
+
+// Media returns downloadable files attached to the message, to its forwarded
+// messages and to the attached wall posts.
+func (m *Message) Media() []Media {
+	return appendMessageMedia(nil, m)
+}
+
+func appendMessageMedia(ms []Media, m *Message) []Media {
+	ms = appendAttachmentsMedia(ms, m.Attachments)
+	for i := range m.FwdMessages {
+		ms = appendMessageMedia(ms, &m.FwdMessages[i])
+	}
+	return ms
+}
+
+func appendPostMedia(ms []Media, p *Post) []Media {
+	ms = appendAttachmentsMedia(ms, p.Attachments)
+	for i := range p.CopyHistory {
+		ms = appendPostMedia(ms, &p.CopyHistory[i])
+	}
+	return ms
+}
+
+func appendAttachmentsMedia(ms []Media, as []Attachment) []Media {
+	for i := range as {
+		a := &as[i]
+		if a.Type == AttachmentWall && a.Wall != nil {
+			ms = appendPostMedia(ms, a.Wall)
+			continue
+		}
+		if m, ok := a.Media(); ok {
+			ms = append(ms, m)
+		}
+	}
+	return ms
+}
+
+func largestSize(sizes []PhotoSize) (max PhotoSize) {
+	for _, size := range sizes {
+		if max.Src == "" || max.Type.Less(size.Type) {
+			max = size
+		}
+	}
+	return max
+}
+
+func largestImage(images []StickerImage) (max StickerImage) {
+	for _, img := range images {
+		if img.Width > max.Width {
+			max = img
+		}
+	}
+	return max
+}
@@ -0,0 +1,128 @@
+package vk_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/gobwas/vk"
+)
+
+func TestMessageMedia(t *testing.T) {
+	p, err := ioutil.ReadFile(filepath.Join("testdata", "message.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var msg vk.Message
+	if err := msg.UnmarshalJSON(p); err != nil {
+		t.Fatal(err)
+	}
+
+	var types []string
+	for i := range msg.Attachments {
+		a := &msg.Attachments[i]
+		types = append(types, string(a.Type)+" "+fmt.Sprintf("%T", a.Object()))
+	}
+	expTypes := []string{
+		"photo *vk.Photo",
+		"video *vk.Video",
+		"audio *vk.Audio",
+		"doc *vk.Doc",
+		"link *vk.Link",
+		"sticker *vk.Sticker",
+		"poll *vk.Poll",
+		"gift *vk.Gift",
+		"graffiti *vk.Graffiti",
+		"audio_message *vk.AudioMessage",
+		"market *vk.MarketItem",
+		"wall *vk.Post",
+	}
+	if !reflect.DeepEqual(types, expTypes) {
+		t.Errorf("unexpected attachments:\n%q\nwant:\n%q", types, expTypes)
+	}
+
+	var (
+		names []string
+		urls  []string
+	)
+	for _, m := range msg.Media() {
+		names = append(names, m.Name())
+		urls = append(urls, m.URL)
+	}
+	expNames := []string{
+		"photo1_10.jpg",
+		"audio1_20.mp3",
+		"doc1_30.gif",
+		"sticker0_40.png",
+		"gift0_50.jpg",
+		"graffiti1_60.png",
+		"audio_message1_70.mp3",
+		"market-1_80.jpg",
+		// Wall post attachments and its copy history.
+		"photo1_90.jpg",
+		"doc-1_100.pdf",
+		// Forwarded messages.
+		"photo2_110.jpg",
+		"audio_message3_120.mp3",
+	}
+	if !reflect.DeepEqual(names, expNames) {
+		t.Errorf("unexpected media names:\n%q\nwant:\n%q", names, expNames)
+	}
+	expURLs := []string{
+		"https://pp.userapi.com/w.jpg?size=w",
+		"https://cs.vk.me/a.mp3?extra=1",
+		"https://vk.com/doc1_30?hash=1",
+		"https://vk.com/sticker/256.png",
+		"https://vk.com/gift/256.jpg",
+		"https://vk.com/graffiti.png",
+		"https://vk.com/voice.mp3",
+		"https://vk.com/market.jpg",
+		"https://pp.userapi.com/m.jpg",
+		"https://vk.com/doc-1_100",
+		"https://pp.userapi.com/z.jpg",
+		"https://vk.com/voice2.mp3",
+	}
+	if !reflect.DeepEqual(urls, expURLs) {
+		t.Errorf("unexpected media urls:\n%q\nwant:\n%q", urls, expURLs)
+	}
+
+	// Post.Media() walks the same tree starting from the attached post.
+	post := msg.Attachments[len(msg.Attachments)-1].Wall
+	names = names[:0]
+	for _, m := range post.Media() {
+		names = append(names, m.Name())
+	}
+	if exp := []string{"photo1_90.jpg", "doc-1_100.pdf"}; !reflect.DeepEqual(names, exp) {
+		t.Errorf("unexpected post media names: %q; want %q", names, exp)
+	}
+}
+
+func TestMediaName(t *testing.T) {
+	for _, test := range []struct {
+		media vk.Media
+		exp   string
+	}{
+		{
+			media: vk.Media{Type: vk.AttachmentPhoto, OwnerID: 1, ID: 2, URL: "https://x/a.jpg"},
+			exp:   "photo1_2.jpg",
+		},
+		{
+			media: vk.Media{Type: vk.AttachmentAudio, OwnerID: -1, ID: 2, URL: "https://x/a.mp3?extra=1"},
+			exp:   "audio-1_2.mp3",
+		},
+		{
+			media: vk.Media{Type: vk.AttachmentDoc, OwnerID: 1, ID: 2, URL: "https://x/doc1_2?hash=1", Ext: ".gif"},
+			exp:   "doc1_2.gif",
+		},
+		{
+			media: vk.Media{Type: vk.AttachmentDoc, OwnerID: 1, ID: 2, URL: "https://x/doc1_2"},
+			exp:   "doc1_2",
+		},
+	} {
+		if act := test.media.Name(); act != test.exp {
+			t.Errorf("Name() = %q; want %q", act, test.exp)
+		}
+	}
+}
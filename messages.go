@@ -20,21 +20,21 @@ type Messages struct {
 }
 
 type Message struct {
-	ID          int           `json:"id"`
-	UserID      int           `json:"user_id"`
-	FromID      int           `json:"from_id"`
-	Date        int64         `json:"date"`
-	ReadState   int           `json:"read_state"`
-	Out         int           `json:"out"`
-	Title       string        `json:"title"`
-	Body        string        `json:"body"`
-	Geo         Geo           `json:"geo"`
-	Attachments []Attachement `json:"attachments"`
-	FwdMessages []Message     `json:"fwd_messages"`
-	Emoji       int           `json:"emoji"`
-	Important   int           `json:"important"`
-	Deleted     int           `json:"deleted"`
-	RandomId    int           `json:"random_id"`
+	ID          int          `json:"id"`
+	UserID      int          `json:"user_id"`
+	FromID      int          `json:"from_id"`
+	Date        UnixTime     `json:"date"`
+	ReadState   BoolInt      `json:"read_state"`
+	Out         BoolInt      `json:"out"`
+	Title       string       `json:"title"`
+	Body        string       `json:"body"`
+	Geo         Geo          `json:"geo"`
+	Attachments []Attachment `json:"attachments"`
+	FwdMessages []Message    `json:"fwd_messages"`
+	Emoji       int          `json:"emoji"`
+	Important   BoolInt      `json:"important"`
+	Deleted     BoolInt      `json:"deleted"`
+	RandomId    int          `json:"random_id"`
 	// Chat fields.
 	ChatID      int    `json:"chat_id"`
 	ChatActive  []int  `json:"chat_active"`
@@ -47,9 +47,7 @@ type Message struct {
 	Photo50     string `json:"photo_50"`
 	Photo100    string `json:"photo_100"`
 	Photo200    string `json:"photo_200"`
-}
 
-type Attachement struct {
-	Type  string `json:"type"`
-	Photo Photo  `json:"photo"`
+	// Extra holds fields unknown to Message.
+	Extra Extra `json:"-"`
 }
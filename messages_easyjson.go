@@ -158,11 +158,11 @@ func easyjson66c1e240DecodeGithubComGobwasVk1(in *jlexer.Lexer, out *Message) {
 		case "from_id":
 			out.FromID = int(in.Int())
 		case "date":
-			out.Date = int64(in.Int64())
+			(out.Date).UnmarshalEasyJSON(in)
 		case "read_state":
-			out.ReadState = int(in.Int())
+			(out.ReadState).UnmarshalEasyJSON(in)
 		case "out":
-			out.Out = int(in.Int())
+			(out.Out).UnmarshalEasyJSON(in)
 		case "title":
 			out.Title = string(in.String())
 		case "body":
@@ -177,15 +177,15 @@ func easyjson66c1e240DecodeGithubComGobwasVk1(in *jlexer.Lexer, out *Message) {
 				in.Delim('[')
 				if out.Attachments == nil {
 					if !in.IsDelim(']') {
-						out.Attachments = make([]Attachement, 0, 1)
+						out.Attachments = make([]Attachment, 0, 1)
 					} else {
-						out.Attachments = []Attachement{}
+						out.Attachments = []Attachment{}
 					}
 				} else {
 					out.Attachments = (out.Attachments)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v4 Attachement
+					var v4 Attachment
 					(v4).UnmarshalEasyJSON(in)
 					out.Attachments = append(out.Attachments, v4)
 					in.WantComma()
@@ -218,9 +218,9 @@ func easyjson66c1e240DecodeGithubComGobwasVk1(in *jlexer.Lexer, out *Message) {
 		case "emoji":
 			out.Emoji = int(in.Int())
 		case "important":
-			out.Important = int(in.Int())
+			(out.Important).UnmarshalEasyJSON(in)
 		case "deleted":
-			out.Deleted = int(in.Int())
+			(out.Deleted).UnmarshalEasyJSON(in)
 		case "random_id":
 			out.RandomId = int(in.Int())
 		case "chat_id":
@@ -267,7 +267,7 @@ func easyjson66c1e240DecodeGithubComGobwasVk1(in *jlexer.Lexer, out *Message) {
 		case "photo_200":
 			out.Photo200 = string(in.String())
 		default:
-			in.SkipRecursive()
+			out.UnmarshalUnknown(in, key)
 		}
 		in.WantComma()
 	}
@@ -318,7 +318,7 @@ func easyjson66c1e240EncodeGithubComGobwasVk1(out *jwriter.Writer, in Message) {
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int64(int64(in.Date))
+		(in.Date).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"read_state\":"
@@ -328,7 +328,7 @@ func easyjson66c1e240EncodeGithubComGobwasVk1(out *jwriter.Writer, in Message) {
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.ReadState))
+		(in.ReadState).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"out\":"
@@ -338,7 +338,7 @@ func easyjson66c1e240EncodeGithubComGobwasVk1(out *jwriter.Writer, in Message) {
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.Out))
+		(in.Out).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"title\":"
@@ -430,7 +430,7 @@ func easyjson66c1e240EncodeGithubComGobwasVk1(out *jwriter.Writer, in Message) {
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.Important))
+		(in.Important).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"deleted\":"
@@ -440,7 +440,7 @@ func easyjson66c1e240EncodeGithubComGobwasVk1(out *jwriter.Writer, in Message) {
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.Deleted))
+		(in.Deleted).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"random_id\":"
@@ -573,6 +573,7 @@ func easyjson66c1e240EncodeGithubComGobwasVk1(out *jwriter.Writer, in Message) {
 		}
 		out.String(string(in.Photo200))
 	}
+	in.MarshalUnknowns(out, false)
 	out.RawByte('}')
 }
 
@@ -821,86 +822,3 @@ func (v *Dialog) UnmarshalJSON(data []byte) error {
 func (v *Dialog) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson66c1e240DecodeGithubComGobwasVk3(l, v)
 }
-func easyjson66c1e240DecodeGithubComGobwasVk4(in *jlexer.Lexer, out *Attachement) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeString()
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "type":
-			out.Type = string(in.String())
-		case "photo":
-			(out.Photo).UnmarshalEasyJSON(in)
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjson66c1e240EncodeGithubComGobwasVk4(out *jwriter.Writer, in Attachement) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"type\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.Type))
-	}
-	{
-		const prefix string = ",\"photo\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		(in.Photo).MarshalEasyJSON(out)
-	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v Attachement) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson66c1e240EncodeGithubComGobwasVk4(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Attachement) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson66c1e240EncodeGithubComGobwasVk4(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *Attachement) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson66c1e240DecodeGithubComGobwasVk4(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Attachement) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson66c1e240DecodeGithubComGobwasVk4(l, v)
-}
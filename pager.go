@@ -0,0 +1,171 @@
+package vk
+
+import (
+	"context"
+	"iter"
+
+	"github.com/mailru/easyjson"
+	"github.com/mailru/easyjson/jlexer"
+)
+
+// Pager iterates over items of paginated method responses like
+// {"count":N,"items":[...]}.
+//
+// Typical usage is:
+//
+//	p := vk.NewPager[vk.Photo](&vk.Iterator{
+//		Method:  "photos.get",
+//		Options: opts,
+//	})
+//	for p.Next(ctx) {
+//		photo := p.Item()
+//	}
+//	if err := p.Err(); err != nil {
+//		// handle error
+//	}
+type Pager[T any] struct {
+	it     *Iterator
+	parse  func([]byte) (int, error)
+	decode func(*jlexer.Lexer, *T)
+
+	items []T
+	pos   int
+	item  T
+	taken int
+	limit int
+}
+
+// NewPager returns Pager which fetches pages with it. If it has Parse function
+// set, it is called with every raw page before items are decoded; that is,
+// Parse could be used to inspect or save pages. Number returned by such Parse
+// is ignored.
+func NewPager[T any, PT interface {
+	*T
+	easyjson.Unmarshaler
+}](it *Iterator) *Pager[T] {
+	p := &Pager[T]{
+		it:    it,
+		parse: it.Parse,
+		decode: func(l *jlexer.Lexer, item *T) {
+			PT(item).UnmarshalEasyJSON(l)
+		},
+	}
+	it.Parse = p.parsePage
+	return p
+}
+
+// Take limits the number of items returned by p to n. It returns p.
+func (p *Pager[T]) Take(n int) *Pager[T] {
+	p.limit = n
+	return p
+}
+
+// Next prepares next item to be returned by Item(). It returns false if there
+// are no more items or error occured. In the latter case Err() returns
+// non-nil error.
+func (p *Pager[T]) Next(ctx context.Context) bool {
+	if p.limit > 0 && p.taken >= p.limit {
+		p.it.Close()
+		return false
+	}
+	for p.pos == len(p.items) {
+		if !p.it.Next(ctx) {
+			return false
+		}
+	}
+	p.item = p.items[p.pos]
+	p.pos++
+	p.taken++
+	return true
+}
+
+// Item returns the item prepared by Next().
+func (p *Pager[T]) Item() T {
+	return p.item
+}
+
+// Err returns error occured during iteration.
+func (p *Pager[T]) Err() error {
+	return p.it.Err()
+}
+
+// Count returns the total number of items reported by the method. It returns
+// -1 if it is unknown, e.g. when Next() was not called yet.
+func (p *Pager[T]) Count() int {
+	return p.it.Count()
+}
+
+// All returns iterator over remaining items. Error must be checked with Err()
+// after the iteration.
+func (p *Pager[T]) All(ctx context.Context) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for p.Next(ctx) {
+			if !yield(p.Item()) {
+				p.it.Close()
+				return
+			}
+		}
+	}
+}
+
+// Chan returns channel which receives remaining items. Channel is closed
+// when there are no more items, error occured or ctx is done. Error must be
+// checked with Err() after the channel is closed.
+func (p *Pager[T]) Chan(ctx context.Context) <-chan T {
+	ch := make(chan T)
+	go func() {
+		defer close(ch)
+		for p.Next(ctx) {
+			select {
+			case ch <- p.Item():
+			case <-ctx.Done():
+				p.it.Close()
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+// Collect returns all remaining items.
+func (p *Pager[T]) Collect(ctx context.Context) (ret []T, err error) {
+	for p.Next(ctx) {
+		ret = append(ret, p.Item())
+	}
+	return ret, p.Err()
+}
+
+func (p *Pager[T]) parsePage(bts []byte) (int, error) {
+	if p.parse != nil {
+		if _, err := p.parse(bts); err != nil {
+			return 0, err
+		}
+	}
+	p.items = p.items[:0]
+	p.pos = 0
+
+	in := jlexer.Lexer{Data: bts}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if key != "items" || in.IsNull() {
+			in.SkipRecursive()
+			in.WantComma()
+			continue
+		}
+		in.Delim('[')
+		for !in.IsDelim(']') {
+			var item T
+			p.decode(&in, &item)
+			p.items = append(p.items, item)
+			in.WantComma()
+		}
+		in.Delim(']')
+		in.WantComma()
+	}
+	in.Delim('}')
+	in.Consumed()
+
+	return len(p.items), in.Error()
+}
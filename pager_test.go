@@ -0,0 +1,89 @@
+package vk_test
+
+import (
+	"context"
+	"testing"
+
+	"golang.org/x/time/rate"
+
+	"github.com/gobwas/vk"
+	"github.com/gobwas/vk/vktest"
+)
+
+func TestPager(t *testing.T) {
+	const total = 250
+
+	for _, test := range []struct {
+		name        string
+		parallelism int
+		take        int
+		exp         int
+		calls       int
+	}{
+		{
+			name:  "sequential",
+			exp:   total,
+			calls: 3,
+		},
+		{
+			name:        "parallel",
+			parallelism: 4,
+			exp:         total,
+			calls:       3,
+		},
+		{
+			name:  "take",
+			take:  150,
+			exp:   150,
+			calls: 2,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			state := &vktest.State{}
+			for i := 0; i < total; i++ {
+				state.Posts = append(state.Posts, vk.Post{
+					ID:      total - i,
+					OwnerID: 1,
+					FromID:  1,
+				})
+			}
+			srv := vktest.NewServer(state)
+			defer srv.Close()
+
+			p := vk.NewPager[vk.Post](&vk.Iterator{
+				Client: srv.Client(),
+				Method: "wall.get",
+				Options: vk.QueryOptions(
+					vk.WithAccessToken(srv.Token(1)),
+					vk.WithNumber("count", 100),
+				),
+				Limiter:     rate.NewLimiter(rate.Inf, 1),
+				Parallelism: test.parallelism,
+			})
+			if n := p.Count(); n != -1 {
+				t.Errorf("Count() before iteration is %d; want -1", n)
+			}
+			if test.take > 0 {
+				p.Take(test.take)
+			}
+			posts, err := p.Collect(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n := len(posts); n != test.exp {
+				t.Fatalf("got %d posts; want %d", n, test.exp)
+			}
+			for i, post := range posts {
+				if exp := total - i; post.ID != exp {
+					t.Fatalf("post #%d has id %d; want %d", i, post.ID, exp)
+				}
+			}
+			if n := p.Count(); n != total {
+				t.Errorf("Count() is %d; want %d", n, total)
+			}
+			if n := len(srv.Calls()); n != test.calls {
+				t.Errorf("server received %d calls; want %d", n, test.calls)
+			}
+		})
+	}
+}
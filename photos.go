@@ -48,9 +48,12 @@ type Photo struct {
 	OwnerID int         `json:"owner_id"`
 	UserID  int         `json:"user_id"`
 	Text    string      `json:"text"`
-	Date    int         `json:"date"`
+	Date    UnixTime    `json:"date"`
 	PostID  int         `json:"post_id"`
 	Sizes   []PhotoSize `json:"sizes"`
+
+	// Extra holds fields unknown to Photo.
+	Extra Extra `json:"-"`
 }
 
 type PhotoSize struct {
@@ -945,7 +945,7 @@ func easyjson7ce75f0fDecodeGithubComGobwasVk6(in *jlexer.Lexer, out *Photo) {
 		case "text":
 			out.Text = string(in.String())
 		case "date":
-			out.Date = int(in.Int())
+			(out.Date).UnmarshalEasyJSON(in)
 		case "post_id":
 			out.PostID = int(in.Int())
 		case "sizes":
@@ -972,7 +972,7 @@ func easyjson7ce75f0fDecodeGithubComGobwasVk6(in *jlexer.Lexer, out *Photo) {
 				in.Delim(']')
 			}
 		default:
-			in.SkipRecursive()
+			out.UnmarshalUnknown(in, key)
 		}
 		in.WantComma()
 	}
@@ -1043,7 +1043,7 @@ func easyjson7ce75f0fEncodeGithubComGobwasVk6(out *jwriter.Writer, in Photo) {
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.Date))
+		(in.Date).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"post_id\":"
@@ -1076,6 +1076,7 @@ func easyjson7ce75f0fEncodeGithubComGobwasVk6(out *jwriter.Writer, in Photo) {
 			out.RawByte(']')
 		}
 	}
+	in.MarshalUnknowns(out, false)
 	out.RawByte('}')
 }
 
@@ -0,0 +1,77 @@
+package vk
+
+import "context"
+
+type prefetchConfig struct {
+	param       string
+	offset      int
+	size        int
+	count       int
+	parallelism int
+}
+
+type prefetchPage struct {
+	done chan struct{}
+	bts  []byte
+	err  error
+}
+
+// prefetcher fetches pages concurrently and returns them in order. Number of
+// pages being fetched or waiting to be consumed is limited by parallelism.
+// Pages are fetched with the same Caller, which is safe for concurrent use.
+type prefetcher struct {
+	cancel context.CancelFunc
+	queue  chan *prefetchPage
+}
+
+func startPrefetch(ctx context.Context, c *Caller, config prefetchConfig) *prefetcher {
+	ctx, cancel := context.WithCancel(ctx)
+	p := &prefetcher{
+		cancel: cancel,
+		// Consumer holds one page while waiting for it, thus the rest
+		// parallelism-1 pages are held by the queue.
+		queue: make(chan *prefetchPage, config.parallelism-1),
+	}
+	go func() {
+		defer close(p.queue)
+		for offset := config.offset; offset < config.count; offset += config.size {
+			page := &prefetchPage{
+				done: make(chan struct{}),
+			}
+			select {
+			case p.queue <- page:
+			case <-ctx.Done():
+				return
+			}
+			go func(offset int) {
+				defer close(page.done)
+				page.bts, page.err = c.Call(ctx,
+					WithNumber(config.param, offset),
+				)
+			}(offset)
+		}
+	}()
+	return p
+}
+
+func (p *prefetcher) next(ctx context.Context) (bts []byte, ok bool, err error) {
+	var page *prefetchPage
+	select {
+	case page, ok = <-p.queue:
+		if !ok {
+			return nil, false, nil
+		}
+	case <-ctx.Done():
+		return nil, false, ctx.Err()
+	}
+	select {
+	case <-page.done:
+		return page.bts, true, page.err
+	case <-ctx.Done():
+		return nil, false, ctx.Err()
+	}
+}
+
+func (p *prefetcher) stop() {
+	p.cancel()
+}
@@ -2,8 +2,7 @@ package vk
 
 import (
 	"context"
-	"io/ioutil"
-	"net/http"
+	"errors"
 	"net/url"
 	"strconv"
 	"strings"
@@ -11,8 +10,6 @@ import (
 	"time"
 
 	"golang.org/x/time/rate"
-
-	"github.com/gobwas/vk/internal/httputil"
 )
 
 var (
@@ -24,11 +21,6 @@ type Authorizer interface {
 	Authorize() (token string, err error)
 }
 
-type request struct {
-	method string
-	query  url.Values
-}
-
 type QueryOption func(url.Values)
 
 func QueryOptions(options ...QueryOption) []QueryOption {
@@ -89,28 +81,15 @@ func WithAccessToken(access *AccessToken) QueryOption {
 	}
 }
 
-func Request(ctx context.Context, method string, options ...QueryOption) ([]byte, error) {
-	req := &request{
-		method: method,
-		query:  make(url.Values),
-	}
-	req.query.Set("v", version)
-
-	WithOptions(options)(req.query)
+// WithOwnerID sets owner_id parameter to id of the token owner. That is, it
+// works both for user and community tokens.
+func WithOwnerID(access *AccessToken) QueryOption {
+	return WithNumber("owner_id", access.OwnerID())
+}
 
-	r, err := http.NewRequest("GET", req.url(), nil)
-	if err != nil {
-		return nil, err
-	}
-	resp, err := http.DefaultClient.Do(r.WithContext(ctx))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-	if err := httputil.CheckResponseStatus(resp); err != nil {
-		return nil, err
-	}
-	return ioutil.ReadAll(resp.Body)
+// Request makes API call with DefaultClient.
+func Request(ctx context.Context, method string, options ...QueryOption) ([]byte, error) {
+	return DefaultClient.Request(ctx, method, options...)
 }
 
 func StripResponse(p []byte) ([]byte, error) {
@@ -124,114 +103,303 @@ func StripResponse(p []byte) ([]byte, error) {
 	return response.Body, nil
 }
 
-func (req *request) url() string {
-	u, err := url.Parse("https://api.vk.com/method")
-	if err != nil {
-		panic("constant url is invalid: " + err.Error())
-	}
-	u.Path += "/" + req.method
-	u.RawQuery = req.query.Encode()
-	return u.String()
-}
+// DefaultCaptchaAttempts is the maximum number of captcha challenges resolved
+// within a single Caller.Call() if Caller.CaptchaAttempts is not set.
+const DefaultCaptchaAttempts = 3
 
+// Caller makes calls of a single method. It is safe for concurrent use.
 type Caller struct {
+	// Client is used to make calls. If nil, DefaultClient is used.
+	Client *Client
+
 	Method         string
 	Options        []QueryOption
 	Limiter        *rate.Limiter
 	ResolveCaptcha func(ctx context.Context, img string) (text string, err error)
 
+	// CaptchaAttempts is the maximum number of captcha challenges resolved
+	// within a single Call(). If zero, DefaultCaptchaAttempts is used.
+	CaptchaAttempts int
+
+	// CaptchaGate is used to share captcha challenges with other Callers. If
+	// nil, every challenge is resolved with ResolveCaptcha.
+	CaptchaGate *CaptchaGate
+
+	// Batcher is used to make calls within execute batches if non-nil. In
+	// that case Limiter is not used.
+	Batcher *Batcher
+
+	// Retry is used to decide whether failed call should be made again. If
+	// nil, DefaultRetryPolicy is used.
+	Retry RetryPolicy
+
+	// Reauthorize is called when call fails with ErrNoAuth. If it returns
+	// new token, call is made again with it once. Subsequent calls are made
+	// with the new token too.
+	Reauthorize func(ctx context.Context) (*AccessToken, error)
+
+	mu      sync.Mutex
 	runtime []QueryOption
 }
 
 func (c *Caller) Call(ctx context.Context, opts ...QueryOption) ([]byte, error) {
-retry:
-	if lim := c.Limiter; lim != nil {
-		if err := lim.Wait(ctx); err != nil {
-			return nil, err
-		}
+	retry := c.Retry
+	if retry == nil {
+		retry = DefaultRetryPolicy
 	}
-	bts, err := Request(ctx, c.Method,
-		WithOptions(c.Options),
-		WithOptions(c.runtime),
-		WithOptions(opts),
+	var (
+		attempt      int
+		captchas     int
+		reauthorized bool
+		answer       []QueryOption
 	)
+call:
+	seen := c.CaptchaGate.version()
+	bts, err := c.call(ctx, opts, answer)
 	if err == nil {
-		bts, err = StripResponse(bts)
+		return bts, nil
 	}
-	if c.Limiter != nil && TemporaryError(err) {
-		goto retry
+	// Captcha answer is valid for a single request only.
+	answer = nil
+	if reauth := c.Reauthorize; reauth != nil && !reauthorized && errors.Is(err, ErrNoAuth) {
+		reauthorized = true
+		token, err := reauth(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.mu.Lock()
+		c.runtime = append(c.runtime, WithAccessToken(token))
+		c.mu.Unlock()
+		goto call
 	}
-	if captcha := c.ResolveCaptcha; captcha != nil {
-		sid, img, ok := CaptchaError(err)
-		if ok {
-			text, rerr := c.ResolveCaptcha(ctx, img)
-			if rerr == nil {
-				c.runtime = append(c.runtime,
+	if sid, img, ok := CaptchaError(err); ok && c.ResolveCaptcha != nil && captchas < c.captchaAttempts() {
+		captchas++
+		sid, text, rerr := c.CaptchaGate.resolve(ctx, seen, sid, img, c.ResolveCaptcha)
+		if rerr == nil {
+			if text != "" {
+				answer = QueryOptions(
 					WithParam("captcha_sid", sid),
 					WithParam("captcha_key", text),
 				)
-				goto retry
 			}
+			goto call
 		}
 	}
-	return bts, err
+	attempt++
+	if delay, ok := retry.Retry(attempt, err); ok {
+		if err := sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+		goto call
+	}
+	return nil, err
+}
+
+func (c *Caller) captchaAttempts() int {
+	if c.CaptchaAttempts > 0 {
+		return c.CaptchaAttempts
+	}
+	return DefaultCaptchaAttempts
+}
+
+func (c *Caller) call(ctx context.Context, opts, answer []QueryOption) ([]byte, error) {
+	c.mu.Lock()
+	runtime := c.runtime
+	c.mu.Unlock()
+
+	if b := c.Batcher; b != nil {
+		return b.Call(ctx, c.Method,
+			WithOptions(c.Options),
+			WithOptions(runtime),
+			WithOptions(opts),
+			WithOptions(answer),
+		)
+	}
+	client := clientOrDefault(c.Client)
+	limiter := c.Limiter
+	if limiter == nil {
+		limiter = client.Limiter
+	}
+	if limiter != nil {
+		if err := limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+	bts, err := client.Request(ctx, c.Method,
+		WithOptions(c.Options),
+		WithOptions(runtime),
+		WithOptions(opts),
+		WithOptions(answer),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return StripResponse(bts)
 }
 
 type Iterator struct {
 	// Caller fields
+	Client  *Client
 	Method  string
 	Options []QueryOption
 	Limiter *rate.Limiter
+	Batcher *Batcher
+	Retry   RetryPolicy
+
+	ResolveCaptcha func(ctx context.Context, img string) (text string, err error)
+	CaptchaGate    *CaptchaGate
+
+	Reauthorize func(ctx context.Context) (*AccessToken, error)
+
+	// Cursor defines how pages are requested. If nil, OffsetCursor is used.
+	Cursor Cursor
+
+	// Parallelism is the maximum number of pages fetched concurrently. If it
+	// is greater than one, Cursor is an OffsetCursor and the total count is
+	// known after the first page, then the rest pages are prefetched by
+	// Parallelism workers using context passed to the first Next() call.
+	// Pages are still parsed in order. Close() must be called if iteration
+	// is stopped before Next() returns false.
+	//
+	// Offsets of prefetched pages are stepped by count parameter of
+	// Options, thus it must not exceed the maximum accepted by the method.
+	Parallelism int
 
 	Parse func([]byte) (int, error)
 
-	once   sync.Once
-	caller Caller
-	offset int
-	err    error
+	once     sync.Once
+	caller   Caller
+	prefetch *prefetcher
+	fetched  int
+	count    int
+	counted  bool
+	done     bool
+	err      error
 }
 
 func (it *Iterator) Next(ctx context.Context) bool {
-	if it.err != nil {
+	if it.err != nil || it.done {
 		return false
 	}
 
 	it.init()
 
-	bts, err := it.caller.Call(ctx,
-		WithNumber("offset", it.offset),
+	var (
+		bts []byte
+		err error
 	)
+	if it.prefetch != nil {
+		var ok bool
+		bts, ok, err = it.prefetch.next(ctx)
+		if err == nil && !ok {
+			it.done = true
+			return false
+		}
+	} else {
+		bts, err = it.caller.Call(ctx, it.Cursor.Options()...)
+	}
 	if err != nil {
 		it.err = err
+		it.Close()
 		return false
 	}
 
 	n, err := it.Parse(bts)
 	if err != nil {
 		it.err = err
+		it.Close()
 		return false
 	}
 	if n == 0 {
+		it.done = true
+		it.Close()
 		return false
 	}
-	it.offset += n
+	it.fetched += n
+
+	page := parsePage(bts, n)
+	if page.Count >= 0 {
+		it.count = page.Count
+		it.counted = true
+	}
+	if !it.Cursor.Advance(page) || (page.Count >= 0 && it.fetched >= page.Count) {
+		// No need to make a request which definitely returns nothing.
+		it.done = true
+		it.Close()
+	}
+	if c, ok := it.Cursor.(*OffsetCursor); ok && !it.done && it.prefetch == nil &&
+		it.Parallelism > 1 && it.counted {
+		// Pages are requested with the step of requested count, cause the
+		// first page could be shorter due to deleted items or server-side
+		// limits.
+		step := n
+		if count := it.requestedCount(); count > n {
+			step = count
+		}
+		it.prefetch = startPrefetch(ctx, &it.caller, prefetchConfig{
+			param:       c.param(),
+			offset:      c.offset - n + step,
+			size:        step,
+			count:       it.count,
+			parallelism: it.Parallelism,
+		})
+	}
 
 	return true
 }
 
+// requestedCount returns count parameter set by it.Options. It returns zero
+// if count is not set.
+func (it *Iterator) requestedCount() int {
+	query := make(url.Values)
+	WithOptions(it.Options)(query)
+	n, _ := strconv.Atoi(query.Get("count"))
+	return n
+}
+
+// Close stops prefetching of pages. It is a no-op if Parallelism is not set.
+func (it *Iterator) Close() {
+	if it.prefetch != nil {
+		it.prefetch.stop()
+	}
+}
+
+// Count returns the total number of items reported by the last received
+// page. It returns -1 if it is unknown.
+func (it *Iterator) Count() int {
+	if !it.counted {
+		return -1
+	}
+	return it.count
+}
+
 func (it *Iterator) Err() error {
 	return it.err
 }
 
 func (it *Iterator) init() {
 	it.once.Do(func() {
+		if it.Cursor == nil {
+			it.Cursor = &OffsetCursor{}
+		}
+		if it.Limiter == nil {
+			it.Limiter = clientOrDefault(it.Client).Limiter
+		}
 		if it.Limiter == nil {
 			it.Limiter = DefaultLimiter()
 		}
 		it.caller = Caller{
+			Client:  it.Client,
 			Method:  it.Method,
 			Options: it.Options,
 			Limiter: it.Limiter,
+			Batcher: it.Batcher,
+			Retry:   it.Retry,
+
+			ResolveCaptcha: it.ResolveCaptcha,
+			CaptchaGate:    it.CaptchaGate,
+
+			Reauthorize: it.Reauthorize,
 		}
 	})
 }
@@ -242,17 +410,3 @@ func DefaultLimiter() *rate.Limiter {
 		DefaultRateBurst,
 	)
 }
-
-func TemporaryError(err error) bool {
-	if vkErr, ok := err.(*Error); ok {
-		return vkErr.Temporary()
-	}
-	return false
-}
-
-func CaptchaError(err error) (sid, img string, ok bool) {
-	if vkErr, ok := err.(*Error); ok {
-		return vkErr.CaptchaSID, vkErr.CaptchaImg, vkErr.CaptchaSID != ""
-	}
-	return "", "", false
-}
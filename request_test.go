@@ -0,0 +1,75 @@
+package vk_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"golang.org/x/time/rate"
+
+	"github.com/gobwas/vk"
+	"github.com/gobwas/vk/vktest"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// pagesTransport serves count items with ids equal to their offsets. The
+// first page is cut to the first items only, like if the rest of them were
+// deleted.
+func pagesTransport(count, first int) http.RoundTripper {
+	return roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		q := req.URL.Query()
+		offset, _ := strconv.Atoi(q.Get("offset"))
+		size, _ := strconv.Atoi(q.Get("count"))
+		if offset == 0 {
+			size = first
+		}
+		var ids []string
+		for i := offset; i < offset+size && i < count; i++ {
+			ids = append(ids, `{"id":`+strconv.Itoa(i)+`}`)
+		}
+		body := `{"response":{"count":` + strconv.Itoa(count) +
+			`,"items":[` + strings.Join(ids, ",") + `]}}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(strings.NewReader(body)),
+			Request:    req,
+		}, nil
+	})
+}
+
+func TestIteratorPrefetchShortPage(t *testing.T) {
+	client := vktest.NewClient(pagesTransport(100, 7))
+	p := vk.NewPager[vk.Group](&vk.Iterator{
+		Client:      client,
+		Method:      "groups.get",
+		Options:     vk.QueryOptions(vk.WithNumber("count", 10)),
+		Limiter:     rate.NewLimiter(rate.Inf, 1),
+		Parallelism: 4,
+	})
+	ids, err := p.Collect(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var exp []int
+	for i := 0; i < 100; i++ {
+		if i < 7 || i >= 10 {
+			exp = append(exp, i)
+		}
+	}
+	if len(ids) != len(exp) {
+		t.Fatalf("got %d items; want %d", len(ids), len(exp))
+	}
+	for i, g := range ids {
+		if g.ID != exp[i] {
+			t.Fatalf("item #%d has id %d; want %d", i, g.ID, exp[i])
+		}
+	}
+}
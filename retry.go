@@ -0,0 +1,169 @@
+package vk
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net"
+	"time"
+
+	"github.com/gobwas/vk/internal/httputil"
+)
+
+// RetryPolicy decides whether failed call should be made again.
+type RetryPolicy interface {
+	// Retry receives number of attempts made so far and the error of the last
+	// one. It returns delay before next attempt and false if call must not
+	// be retried.
+	Retry(attempt int, err error) (time.Duration, bool)
+}
+
+// RetryFunc is an adapter to allow use of ordinary functions as RetryPolicy.
+type RetryFunc func(attempt int, err error) (time.Duration, bool)
+
+// Retry implements RetryPolicy.
+func (f RetryFunc) Retry(attempt int, err error) (time.Duration, bool) {
+	return f(attempt, err)
+}
+
+// NoRetry is a RetryPolicy which never retries.
+var NoRetry RetryPolicy = RetryFunc(func(int, error) (time.Duration, bool) {
+	return 0, false
+})
+
+// DefaultRetryPolicy is used by Caller and Iterator which have no own
+// policy.
+var DefaultRetryPolicy RetryPolicy = &Backoff{}
+
+const (
+	DefaultMaxAttempts = 5
+	DefaultMinDelay    = 300 * time.Millisecond
+	DefaultMaxDelay    = 10 * time.Second
+	DefaultFactor      = 2
+	DefaultJitter      = 0.5
+)
+
+// Backoff is a RetryPolicy which retries calls failed with retryable errors
+// with exponentially growing delays.
+//
+// Zero value is ready to use and uses defaults described below.
+type Backoff struct {
+	// MaxAttempts is a maximum number of attempts including the first one.
+	// If zero, DefaultMaxAttempts is used.
+	MaxAttempts int
+
+	// MinDelay is a delay before the second attempt. If zero,
+	// DefaultMinDelay is used.
+	MinDelay time.Duration
+
+	// MaxDelay limits delay between attempts. If zero, DefaultMaxDelay is
+	// used.
+	MaxDelay time.Duration
+
+	// Factor is a multiplier of delay on each attempt. If zero,
+	// DefaultFactor is used.
+	Factor float64
+
+	// Jitter is a fraction of delay which is randomly subtracted from it. If
+	// zero, DefaultJitter is used. Negative value disables jitter.
+	Jitter float64
+
+	// Retryable reports whether err could be retried. If nil,
+	// RetryableError() is used.
+	Retryable func(err error) bool
+
+	// Codes overrides decision for particular API error codes.
+	Codes map[ErrorCode]bool
+}
+
+// Retry implements RetryPolicy.
+func (b *Backoff) Retry(attempt int, err error) (time.Duration, bool) {
+	if attempt >= b.maxAttempts() || !b.retryable(err) {
+		return 0, false
+	}
+	var (
+		min    = float64(b.minDelay())
+		max    = float64(b.maxDelay())
+		factor = b.Factor
+		jitter = b.Jitter
+	)
+	if factor == 0 {
+		factor = DefaultFactor
+	}
+	if jitter == 0 {
+		jitter = DefaultJitter
+	}
+	delay := math.Min(min*math.Pow(factor, float64(attempt-1)), max)
+	if jitter > 0 {
+		delay -= delay * math.Min(jitter, 1) * rand.Float64()
+	}
+	return time.Duration(delay), true
+}
+
+func (b *Backoff) retryable(err error) bool {
+	if code, ok := ErrorCodeOf(err); ok {
+		if retry, ok := b.Codes[code]; ok {
+			return retry
+		}
+	}
+	if f := b.Retryable; f != nil {
+		return f(err)
+	}
+	return RetryableError(err)
+}
+
+func (b *Backoff) maxAttempts() int {
+	if n := b.MaxAttempts; n > 0 {
+		return n
+	}
+	return DefaultMaxAttempts
+}
+
+func (b *Backoff) minDelay() time.Duration {
+	if d := b.MinDelay; d > 0 {
+		return d
+	}
+	return DefaultMinDelay
+}
+
+func (b *Backoff) maxDelay() time.Duration {
+	if d := b.MaxDelay; d > 0 {
+		return d
+	}
+	return DefaultMaxDelay
+}
+
+// RetryableError reports whether call failed with err could be retried. That
+// is, err is a network error, an http 5xx status or a temporary API error.
+func RetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if TemporaryError(err) {
+		return true
+	}
+	var status *httputil.StatusError
+	if errors.As(err, &status) {
+		return status.Temporary()
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,76 @@
+package vk_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/gobwas/vk"
+	"github.com/gobwas/vk/vktest"
+)
+
+func TestCallerRetry(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		code  vk.ErrorCode
+		fails int
+		calls int
+		err   error
+	}{
+		{
+			name:  "rate limit",
+			code:  vk.ErrRateLimitExceeded,
+			fails: 2,
+			calls: 3,
+		},
+		{
+			name:  "internal error",
+			code:  vk.ErrInternalError,
+			fails: 2,
+			calls: 3,
+		},
+		{
+			name:  "too many attempts",
+			code:  vk.ErrRateLimitExceeded,
+			fails: 10,
+			calls: 3,
+			err:   vk.ErrRateLimitExceeded,
+		},
+		{
+			name:  "not retryable",
+			code:  vk.ErrAccessDenied,
+			fails: 1,
+			calls: 1,
+			err:   vk.ErrAccessDenied,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			srv := vktest.NewServer(&vktest.State{
+				Users: []vk.User{{ID: 1}},
+			})
+			defer srv.Close()
+			srv.Fail("users.get", test.code, test.fails)
+
+			c := vk.Caller{
+				Client:  srv.Client(),
+				Method:  "users.get",
+				Options: vk.QueryOptions(vk.WithAccessToken(srv.Token(1))),
+				Limiter: rate.NewLimiter(rate.Inf, 1),
+				Retry: &vk.Backoff{
+					MaxAttempts: 3,
+					MinDelay:    time.Millisecond,
+				},
+			}
+			_, err := c.Call(context.Background())
+			if !errors.Is(err, test.err) {
+				t.Errorf("unexpected error: %v; want %v", err, test.err)
+			}
+			if n := len(srv.Calls()); n != test.calls {
+				t.Errorf("server received %d calls; want %d", n, test.calls)
+			}
+		})
+	}
+}
@@ -1,6 +1,11 @@
 package vk
 
-import "strconv"
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
 
 type Scope uint64
 
@@ -10,7 +15,9 @@ const (
 	ScopePhotos        Scope = 1 << 2
 	ScopeAudio         Scope = 1 << 3
 	ScopeVideo         Scope = 1 << 4
+	ScopeStories       Scope = 1 << 6
 	ScopePages         Scope = 1 << 7
+	ScopeMenu          Scope = 1 << 8
 	ScopeStatus        Scope = 1 << 10
 	ScopeNotes         Scope = 1 << 11
 	ScopeMessages      Scope = 1 << 12
@@ -23,8 +30,131 @@ const (
 	ScopeStats         Scope = 1 << 20
 	ScopeEmail         Scope = 1 << 22
 	ScopeMarket        Scope = 1 << 27
+	ScopePhoneNumber   Scope = 1 << 28
 )
 
+var scopeNames = []struct {
+	scope Scope
+	name  string
+}{
+	{ScopeNotify, "notify"},
+	{ScopeFriends, "friends"},
+	{ScopePhotos, "photos"},
+	{ScopeAudio, "audio"},
+	{ScopeVideo, "video"},
+	{ScopeStories, "stories"},
+	{ScopePages, "pages"},
+	{ScopeMenu, "menu"},
+	{ScopeStatus, "status"},
+	{ScopeNotes, "notes"},
+	{ScopeMessages, "messages"},
+	{ScopeWall, "wall"},
+	{ScopeAds, "ads"},
+	{ScopeOffline, "offline"},
+	{ScopeDocs, "docs"},
+	{ScopeGroups, "groups"},
+	{ScopeNotifications, "notifications"},
+	{ScopeStats, "stats"},
+	{ScopeEmail, "email"},
+	{ScopeMarket, "market"},
+	{ScopePhoneNumber, "phone_number"},
+}
+
+// ParseScope parses scope from comma or space separated list of names like
+// "wall,photos,offline". It also accepts a decimal bit mask.
+func ParseScope(s string) (scope Scope, err error) {
+	if n, err := strconv.ParseUint(s, 10, 64); err == nil {
+		return Scope(n), nil
+	}
+	for _, name := range strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ' '
+	}) {
+		x, ok := scopeByName(name)
+		if !ok {
+			return 0, fmt.Errorf("vk: unknown scope: %q", name)
+		}
+		scope |= x
+	}
+	return scope, nil
+}
+
+func scopeByName(name string) (Scope, bool) {
+	for _, x := range scopeNames {
+		if x.name == name {
+			return x.scope, true
+		}
+	}
+	return 0, false
+}
+
+// Has reports whether s contains all permissions of x.
+func (s Scope) Has(x Scope) bool {
+	return s&x == x
+}
+
+// Names returns names of permissions contained in s. Unknown bits are
+// ignored.
+func (s Scope) Names() []string {
+	var names []string
+	for _, x := range scopeNames {
+		if s.Has(x.scope) {
+			names = append(names, x.name)
+		}
+	}
+	return names
+}
+
+// String returns decimal bit mask which is accepted by the API.
 func (s Scope) String() string {
 	return strconv.FormatUint(uint64(s), 10)
 }
+
+// ScopeError is returned when token lacks some of required permissions.
+type ScopeError struct {
+	Missing Scope
+}
+
+func (e *ScopeError) Error() string {
+	return "vk: token has no permissions: " + strings.Join(e.Missing.Names(), ", ")
+}
+
+// AppPermissions returns permissions granted to the app by the token owner.
+func (c *Client) AppPermissions(ctx context.Context, token *AccessToken) (Scope, error) {
+	bts, err := c.Request(ctx, "account.getAppPermissions",
+		WithAccessToken(token),
+	)
+	if err == nil {
+		bts, err = StripResponse(bts)
+	}
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.ParseUint(string(bts), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("vk: bad permissions response: %v", err)
+	}
+	return Scope(n), nil
+}
+
+// CheckScope returns *ScopeError if token has not all of required
+// permissions.
+func (c *Client) CheckScope(ctx context.Context, token *AccessToken, required Scope) error {
+	granted, err := c.AppPermissions(ctx, token)
+	if err != nil {
+		return err
+	}
+	if missing := required &^ granted; missing != 0 {
+		return &ScopeError{Missing: missing}
+	}
+	return nil
+}
+
+// AppPermissions returns permissions of the token with DefaultClient.
+func AppPermissions(ctx context.Context, token *AccessToken) (Scope, error) {
+	return DefaultClient.AppPermissions(ctx, token)
+}
+
+// CheckScope checks permissions of the token with DefaultClient.
+func CheckScope(ctx context.Context, token *AccessToken, required Scope) error {
+	return DefaultClient.CheckScope(ctx, token, required)
+}
@@ -2,7 +2,6 @@ package vk
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/mailru/easyjson"
 )
@@ -32,18 +31,38 @@ type Error struct {
 }
 
 func (e Error) Error() string {
+	if e.Msg == "" {
+		return e.Code.Error()
+	}
 	return fmt.Sprintf(
-		"%s (%d)",
-		strings.ToLower(e.Msg), e.Code,
+		"vk: %s (%d): %s",
+		e.Code.String(), int(e.Code), e.Msg,
 	)
 }
 
+// Unwrap returns e's code. It makes possible to use ErrorCode constants with
+// errors.Is().
+func (e Error) Unwrap() error {
+	return e.Code
+}
+
 func (e Error) Temporary() bool {
-	switch e.Code {
-	case ErrRateLimitExceeded, ErrTooManyActions:
-		return true
-	default:
-		return false
+	return e.Code.Temporary()
+}
+
+// ExecuteError describes failure of a single API call made within execute
+// call.
+type ExecuteError struct {
+	Method string    `json:"method"`
+	Code   ErrorCode `json:"error_code"`
+	Msg    string    `json:"error_msg"`
+}
+
+// Err returns Error representation of e.
+func (e ExecuteError) Err() *Error {
+	return &Error{
+		Code: e.Code,
+		Msg:  e.Msg,
 	}
 }
 
@@ -52,9 +71,77 @@ type RequestParam struct {
 	Value string `json:"value"`
 }
 
+//easyjson:json
+type executeResponse struct {
+	Response      []easyjson.RawMessage `json:"response"`
+	Err           Error                 `json:"error"`
+	ExecuteErrors []ExecuteError        `json:"execute_errors"`
+}
+
+//easyjson:json
+type pageMeta struct {
+	Count    *int                  `json:"count"`
+	NextFrom string                `json:"next_from"`
+	Items    []easyjson.RawMessage `json:"items"`
+}
+
+//easyjson:json
+type pageItem struct {
+	ID int `json:"id"`
+}
+
+//easyjson:json
+type rawTokenInfo struct {
+	Success int   `json:"success"`
+	UserID  int   `json:"user_id"`
+	Date    int64 `json:"date"`
+	Expire  int64 `json:"expire"`
+}
+
+//easyjson:json
+type rawGroupPermissions struct {
+	Mask uint64 `json:"mask"`
+}
+
+//easyjson:json
+type rawDirect struct {
+	Token   string `json:"access_token"`
+	Expires int    `json:"expires_in"`
+	UserID  int    `json:"user_id"`
+
+	Err              string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+
+	ValidationType string `json:"validation_type"`
+	ValidationSID  string `json:"validation_sid"`
+	PhoneMask      string `json:"phone_mask"`
+	RedirectURI    string `json:"redirect_uri"`
+
+	CaptchaSID string `json:"captcha_sid"`
+	CaptchaImg string `json:"captcha_img"`
+}
+
 //easyjson:json
 type rawAccess struct {
 	Token   string `json:"access_token"`
 	Expires int    `json:"expires_in"`
 	UserID  int    `json:"user_id"`
 }
+
+//easyjson:json
+type rawSubscriptions struct {
+	Users struct {
+		Items []int `json:"items"`
+	} `json:"users"`
+}
+
+//easyjson:json
+type rawScreenName struct {
+	Type     string `json:"type"`
+	ObjectID int    `json:"object_id"`
+}
+
+//easyjson:json
+type rawPostID struct {
+	PostID int `json:"post_id"`
+}
@@ -0,0 +1,271 @@
+package vk
+
+import (
+	"context"
+
+	"golang.org/x/time/rate"
+
+	"github.com/mailru/easyjson/jlexer"
+)
+
+// UserField is an optional field of user profile which must be requested
+// explicitly.
+type UserField string
+
+const (
+	UserFieldAbout                  UserField = "about"
+	UserFieldActivities             UserField = "activities"
+	UserFieldBdate                  UserField = "bdate"
+	UserFieldBlacklisted            UserField = "blacklisted"
+	UserFieldBlacklistedByMe        UserField = "blacklisted_by_me"
+	UserFieldBooks                  UserField = "books"
+	UserFieldCanBeInvitedGroup      UserField = "can_be_invited_group"
+	UserFieldCanPost                UserField = "can_post"
+	UserFieldCanSeeAllPosts         UserField = "can_see_all_posts"
+	UserFieldCanSeeAudio            UserField = "can_see_audio"
+	UserFieldCanSendFriendRequest   UserField = "can_send_friend_request"
+	UserFieldCanWritePrivateMessage UserField = "can_write_private_message"
+	UserFieldCareer                 UserField = "career"
+	UserFieldCity                   UserField = "city"
+	UserFieldCommonCount            UserField = "common_count"
+	UserFieldConnections            UserField = "connections"
+	UserFieldContacts               UserField = "contacts"
+	UserFieldCounters               UserField = "counters"
+	UserFieldCountry                UserField = "country"
+	UserFieldCropPhoto              UserField = "crop_photo"
+	UserFieldDomain                 UserField = "domain"
+	UserFieldEducation              UserField = "education"
+	UserFieldExports                UserField = "exports"
+	UserFieldFollowersCount         UserField = "followers_count"
+	UserFieldFriendStatus           UserField = "friend_status"
+	UserFieldGames                  UserField = "games"
+	UserFieldHasMobile              UserField = "has_mobile"
+	UserFieldHasPhoto               UserField = "has_photo"
+	UserFieldHomeTown               UserField = "home_town"
+	UserFieldInterests              UserField = "interests"
+	UserFieldIsFavorite             UserField = "is_favorite"
+	UserFieldIsFriend               UserField = "is_friend"
+	UserFieldIsHiddenFromFeed       UserField = "is_hidden_from_feed"
+	UserFieldLastSeen               UserField = "last_seen"
+	UserFieldLists                  UserField = "lists"
+	UserFieldMaidenName             UserField = "maiden_name"
+	UserFieldMilitary               UserField = "military"
+	UserFieldMovies                 UserField = "movies"
+	UserFieldMusic                  UserField = "music"
+	UserFieldNickname               UserField = "nickname"
+	UserFieldOccupation             UserField = "occupation"
+	UserFieldOnline                 UserField = "online"
+	UserFieldPersonal               UserField = "personal"
+	UserFieldPhoto50                UserField = "photo_50"
+	UserFieldPhoto100               UserField = "photo_100"
+	UserFieldPhoto200               UserField = "photo_200"
+	UserFieldPhoto200Orig           UserField = "photo_200_orig"
+	UserFieldPhoto400Orig           UserField = "photo_400_orig"
+	UserFieldPhotoID                UserField = "photo_id"
+	UserFieldPhotoMax               UserField = "photo_max"
+	UserFieldPhotoMaxOrig           UserField = "photo_max_orig"
+	UserFieldQuotes                 UserField = "quotes"
+	UserFieldRelation               UserField = "relation"
+	UserFieldRelatives              UserField = "relatives"
+	UserFieldSchools                UserField = "schools"
+	UserFieldScreenName             UserField = "screen_name"
+	UserFieldSex                    UserField = "sex"
+	UserFieldSite                   UserField = "site"
+	UserFieldStatus                 UserField = "status"
+	UserFieldTimezone               UserField = "timezone"
+	UserFieldTrending               UserField = "trending"
+	UserFieldTV                     UserField = "tv"
+	UserFieldUniversities           UserField = "universities"
+	UserFieldVerified               UserField = "verified"
+	UserFieldWallDefault            UserField = "wall_default"
+)
+
+// MaxUsersPerRequest is the maximum number of ids accepted by users.get.
+const MaxUsersPerRequest = 1000
+
+// UsersService provides users.* methods.
+type UsersService struct {
+	// Client is used to make calls. If nil, DefaultClient is used.
+	Client *Client
+
+	Token   *AccessToken
+	Limiter *rate.Limiter
+	Retry   RetryPolicy
+
+	// Batcher is used to make calls within execute batches if non-nil. In
+	// that case Limiter is not used.
+	Batcher *Batcher
+}
+
+// Get returns users with given ids. If ids is empty, it returns the token
+// owner. Ids are split into chunks of MaxUsersPerRequest.
+func (s *UsersService) Get(ctx context.Context, ids []int, fields ...UserField) ([]User, error) {
+	if len(ids) == 0 {
+		return s.get(ctx, WithUserFields(fields))
+	}
+	users := make([]User, 0, len(ids))
+	for i := 0; i < len(ids); i += MaxUsersPerRequest {
+		sub := ids[i:]
+		if len(sub) > MaxUsersPerRequest {
+			sub = sub[:MaxUsersPerRequest]
+		}
+		list, err := s.get(ctx,
+			WithNumbers("user_ids", sub...),
+			WithUserFields(fields),
+		)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, list...)
+	}
+	return users, nil
+}
+
+func (s *UsersService) get(ctx context.Context, options ...QueryOption) ([]User, error) {
+	bts, err := s.caller("users.get").Call(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	return parseUsers(bts)
+}
+
+// parseUsers parses bare array of users.
+func parseUsers(p []byte) ([]User, error) {
+	var (
+		in    = jlexer.Lexer{Data: p}
+		users []User
+	)
+	in.Delim('[')
+	for !in.IsDelim(']') {
+		var u User
+		u.UnmarshalEasyJSON(&in)
+		users = append(users, u)
+		in.WantComma()
+	}
+	in.Delim(']')
+	in.Consumed()
+	return users, in.Error()
+}
+
+// UserSearch describes users.search request.
+type UserSearch struct {
+	Query  string
+	Fields []UserField
+
+	// Options are passed to users.search as is, e.g. to filter by city or
+	// age.
+	Options []QueryOption
+}
+
+// Search returns users found by q.
+func (s *UsersService) Search(ctx context.Context, q UserSearch) ([]User, error) {
+	return s.collect(ctx, "users.search",
+		WithOptions(q.Options),
+		WithParam("q", q.Query),
+		WithNumber("count", 1000),
+		WithUserFields(q.Fields),
+	)
+}
+
+// GetFollowers returns followers of the user with given id.
+func (s *UsersService) GetFollowers(ctx context.Context, userID int, fields ...UserField) ([]User, error) {
+	if len(fields) == 0 {
+		// Without fields users.getFollowers returns bare ids.
+		fields = []UserField{UserFieldScreenName}
+	}
+	return s.collect(ctx, "users.getFollowers",
+		WithNumber("user_id", userID),
+		WithNumber("count", 1000),
+		WithUserFields(fields),
+	)
+}
+
+// GetSubscriptions returns users which the user with given id is subscribed
+// to. Subscriptions to communities are not returned.
+func (s *UsersService) GetSubscriptions(ctx context.Context, userID int, fields ...UserField) ([]User, error) {
+	bts, err := s.caller("users.getSubscriptions").Call(ctx,
+		WithNumber("user_id", userID),
+	)
+	if err != nil {
+		return nil, err
+	}
+	var subs rawSubscriptions
+	if err := subs.UnmarshalJSON(bts); err != nil {
+		return nil, err
+	}
+	if len(subs.Users.Items) == 0 {
+		return nil, nil
+	}
+	return s.Get(ctx, subs.Users.Items, fields...)
+}
+
+// ScreenName is an object which short name refers to.
+type ScreenName struct {
+	// Type is one of "user", "group" or "application".
+	Type     string
+	ObjectID int
+}
+
+// ResolveScreenName resolves short name like "durov" with
+// utils.resolveScreenName. It returns ErrNotFound if name is not used.
+func (s *UsersService) ResolveScreenName(ctx context.Context, name string) (ScreenName, error) {
+	bts, err := s.caller("utils.resolveScreenName").Call(ctx,
+		WithParam("screen_name", name),
+	)
+	if err != nil {
+		return ScreenName{}, err
+	}
+	if len(bts) > 0 && bts[0] == '[' {
+		// Empty array is returned for unknown names.
+		return ScreenName{}, ErrNotFound
+	}
+	var ret rawScreenName
+	if err := ret.UnmarshalJSON(bts); err != nil {
+		return ScreenName{}, err
+	}
+	return ScreenName{
+		Type:     ret.Type,
+		ObjectID: ret.ObjectID,
+	}, nil
+}
+
+func (s *UsersService) collect(ctx context.Context, method string, options ...QueryOption) ([]User, error) {
+	return NewPager[User](&Iterator{
+		Client:  s.Client,
+		Method:  method,
+		Options: append(s.options(), options...),
+		Limiter: s.Limiter,
+		Retry:   s.Retry,
+		Batcher: s.Batcher,
+	}).Collect(ctx)
+}
+
+func (s *UsersService) caller(method string) *Caller {
+	return &Caller{
+		Client:  s.Client,
+		Method:  method,
+		Options: s.options(),
+		Limiter: s.Limiter,
+		Retry:   s.Retry,
+		Batcher: s.Batcher,
+	}
+}
+
+func (s *UsersService) options() []QueryOption {
+	if s.Token == nil {
+		return nil
+	}
+	return QueryOptions(WithAccessToken(s.Token))
+}
+
+// WithUserFields sets fields parameter. It is a no-op if fields is empty.
+func WithUserFields(fields []UserField) QueryOption {
+	strs := make([]string, len(fields))
+	for i, f := range fields {
+		strs[i] = string(f)
+	}
+	if len(strs) == 0 {
+		return WithOptions(nil)
+	}
+	return WithStrings("fields", strs...)
+}
@@ -0,0 +1,150 @@
+package vk_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"golang.org/x/time/rate"
+
+	"github.com/gobwas/vk"
+	"github.com/gobwas/vk/vktest"
+)
+
+func usersService(t *testing.T, state *vktest.State) (*vk.UsersService, *vktest.Server) {
+	srv := vktest.NewServer(state)
+	t.Cleanup(srv.Close)
+	return &vk.UsersService{
+		Client:  srv.Client(),
+		Token:   srv.Token(1),
+		Limiter: rate.NewLimiter(rate.Inf, 1),
+	}, srv
+}
+
+func TestUsersGet(t *testing.T) {
+	const n = vk.MaxUsersPerRequest + 500
+
+	state := new(vktest.State)
+	ids := make([]int, n)
+	for i := range ids {
+		ids[i] = i + 1
+		state.Users = append(state.Users, vk.User{ID: i + 1})
+	}
+	s, srv := usersService(t, state)
+	ctx := context.Background()
+
+	users, err := s.Get(ctx, ids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != n {
+		t.Fatalf("got %d users; want %d", len(users), n)
+	}
+	for i, u := range users {
+		if u.ID != ids[i] {
+			t.Fatalf("user #%d has id %d; want %d", i, u.ID, ids[i])
+		}
+	}
+	if calls := len(srv.Calls()); calls != 2 {
+		t.Errorf("server received %d calls; want 2", calls)
+	}
+
+	me, err := s.Get(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(me) != 1 || me[0].ID != 1 {
+		t.Errorf("unexpected token owner: %+v", me)
+	}
+}
+
+func TestUsersRelations(t *testing.T) {
+	s, _ := usersService(t, &vktest.State{
+		Users: []vk.User{
+			{ID: 1, FirstName: "Pavel", LastName: "Durov", ScreenName: "durov"},
+			{ID: 2, FirstName: "Ivan", LastName: "Ivanov"},
+			{ID: 3, FirstName: "Petr", LastName: "Ivanov"},
+		},
+		Followers: map[int][]int{
+			1: {2, 3},
+			3: {1},
+		},
+	})
+	ctx := context.Background()
+
+	for _, test := range []struct {
+		name string
+		get  func() ([]vk.User, error)
+		exp  []int
+	}{
+		{
+			name: "search",
+			get: func() ([]vk.User, error) {
+				return s.Search(ctx, vk.UserSearch{
+					Query:  "ivanov",
+					Fields: []vk.UserField{vk.UserFieldScreenName},
+				})
+			},
+			exp: []int{2, 3},
+		},
+		{
+			name: "followers",
+			get: func() ([]vk.User, error) {
+				return s.GetFollowers(ctx, 1)
+			},
+			exp: []int{2, 3},
+		},
+		{
+			name: "subscriptions",
+			get: func() ([]vk.User, error) {
+				return s.GetSubscriptions(ctx, 1)
+			},
+			exp: []int{3},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			users, err := test.get()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var ids []int
+			for _, u := range users {
+				ids = append(ids, u.ID)
+			}
+			if !equalInts(ids, test.exp) {
+				t.Errorf("got users %v; want %v", ids, test.exp)
+			}
+		})
+	}
+}
+
+func TestResolveScreenName(t *testing.T) {
+	s, _ := usersService(t, &vktest.State{
+		Users: []vk.User{{ID: 1, ScreenName: "durov"}},
+	})
+	ctx := context.Background()
+
+	name, err := s.ResolveScreenName(ctx, "durov")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := (vk.ScreenName{Type: "user", ObjectID: 1}); name != exp {
+		t.Errorf("got %+v; want %+v", name, exp)
+	}
+	_, err = s.ResolveScreenName(ctx, "unknown")
+	if !errors.Is(err, vk.ErrNotFound) {
+		t.Errorf("unexpected error: %v; want %v", err, vk.ErrNotFound)
+	}
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
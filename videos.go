@@ -8,26 +8,29 @@ type Videos struct {
 }
 
 type Video struct {
-	ID          int    `json:"id"`
-	OwnerID     int    `json:"owner_id"`
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	Duration    int    `json:"duration"`
-	Photo130    string `json:"photo_130"`
-	Photo320    string `json:"photo_320"`
-	Photo640    string `json:"photo_640"`
-	Photo800    string `json:"photo_800"`
-	Date        int    `json:"date"`
-	AddingDate  int    `json:"adding_date"`
-	Views       int    `json:"views"`
-	Comments    int    `json:"comments"`
-	Player      string `json:"player"`
-	Platform    string `json:"platform"`
-	CanEdit     int    `json:"can_edit"`
-	CanAdd      int    `json:"can_add"`
-	IsPrivate   int    `json:"is_private"`
-	AccessKey   string `json:"access_key"`
-	Processing  int    `json:"processing"`
-	Live        int    `json:"live"`
-	Upcoming    int    `json:"upcoming"`
+	ID          int      `json:"id"`
+	OwnerID     int      `json:"owner_id"`
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Duration    int      `json:"duration"`
+	Photo130    string   `json:"photo_130"`
+	Photo320    string   `json:"photo_320"`
+	Photo640    string   `json:"photo_640"`
+	Photo800    string   `json:"photo_800"`
+	Date        UnixTime `json:"date"`
+	AddingDate  UnixTime `json:"adding_date"`
+	Views       int      `json:"views"`
+	Comments    int      `json:"comments"`
+	Player      string   `json:"player"`
+	Platform    string   `json:"platform"`
+	CanEdit     BoolInt  `json:"can_edit"`
+	CanAdd      BoolInt  `json:"can_add"`
+	IsPrivate   BoolInt  `json:"is_private"`
+	AccessKey   string   `json:"access_key"`
+	Processing  BoolInt  `json:"processing"`
+	Live        BoolInt  `json:"live"`
+	Upcoming    BoolInt  `json:"upcoming"`
+
+	// Extra holds fields unknown to Video.
+	Extra Extra `json:"-"`
 }
@@ -170,9 +170,9 @@ func easyjsonC3e3fb8cDecodeGithubComGobwasVk1(in *jlexer.Lexer, out *Video) {
 		case "photo_800":
 			out.Photo800 = string(in.String())
 		case "date":
-			out.Date = int(in.Int())
+			(out.Date).UnmarshalEasyJSON(in)
 		case "adding_date":
-			out.AddingDate = int(in.Int())
+			(out.AddingDate).UnmarshalEasyJSON(in)
 		case "views":
 			out.Views = int(in.Int())
 		case "comments":
@@ -182,21 +182,21 @@ func easyjsonC3e3fb8cDecodeGithubComGobwasVk1(in *jlexer.Lexer, out *Video) {
 		case "platform":
 			out.Platform = string(in.String())
 		case "can_edit":
-			out.CanEdit = int(in.Int())
+			(out.CanEdit).UnmarshalEasyJSON(in)
 		case "can_add":
-			out.CanAdd = int(in.Int())
+			(out.CanAdd).UnmarshalEasyJSON(in)
 		case "is_private":
-			out.IsPrivate = int(in.Int())
+			(out.IsPrivate).UnmarshalEasyJSON(in)
 		case "access_key":
 			out.AccessKey = string(in.String())
 		case "processing":
-			out.Processing = int(in.Int())
+			(out.Processing).UnmarshalEasyJSON(in)
 		case "live":
-			out.Live = int(in.Int())
+			(out.Live).UnmarshalEasyJSON(in)
 		case "upcoming":
-			out.Upcoming = int(in.Int())
+			(out.Upcoming).UnmarshalEasyJSON(in)
 		default:
-			in.SkipRecursive()
+			out.UnmarshalUnknown(in, key)
 		}
 		in.WantComma()
 	}
@@ -307,7 +307,7 @@ func easyjsonC3e3fb8cEncodeGithubComGobwasVk1(out *jwriter.Writer, in Video) {
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.Date))
+		(in.Date).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"adding_date\":"
@@ -317,7 +317,7 @@ func easyjsonC3e3fb8cEncodeGithubComGobwasVk1(out *jwriter.Writer, in Video) {
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.AddingDate))
+		(in.AddingDate).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"views\":"
@@ -367,7 +367,7 @@ func easyjsonC3e3fb8cEncodeGithubComGobwasVk1(out *jwriter.Writer, in Video) {
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.CanEdit))
+		(in.CanEdit).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"can_add\":"
@@ -377,7 +377,7 @@ func easyjsonC3e3fb8cEncodeGithubComGobwasVk1(out *jwriter.Writer, in Video) {
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.CanAdd))
+		(in.CanAdd).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"is_private\":"
@@ -387,7 +387,7 @@ func easyjsonC3e3fb8cEncodeGithubComGobwasVk1(out *jwriter.Writer, in Video) {
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.IsPrivate))
+		(in.IsPrivate).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"access_key\":"
@@ -407,7 +407,7 @@ func easyjsonC3e3fb8cEncodeGithubComGobwasVk1(out *jwriter.Writer, in Video) {
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.Processing))
+		(in.Processing).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"live\":"
@@ -417,7 +417,7 @@ func easyjsonC3e3fb8cEncodeGithubComGobwasVk1(out *jwriter.Writer, in Video) {
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.Live))
+		(in.Live).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"upcoming\":"
@@ -427,8 +427,9 @@ func easyjsonC3e3fb8cEncodeGithubComGobwasVk1(out *jwriter.Writer, in Video) {
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.Upcoming))
+		(in.Upcoming).MarshalEasyJSON(out)
 	}
+	in.MarshalUnknowns(out, false)
 	out.RawByte('}')
 }
 
@@ -0,0 +1,12 @@
+package vktest
+
+//go:generate easyjson -all
+
+// Interaction is a recorded pair of http request and response.
+type Interaction struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Params string `json:"params"`
+	Status int    `json:"status"`
+	Body   string `json:"body"`
+}
@@ -0,0 +1,843 @@
+package vktest
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mailru/easyjson/jwriter"
+
+	"github.com/gobwas/vk"
+)
+
+var handlers = map[string]handler{
+	"account.getAppPermissions": accountGetAppPermissions,
+
+	"secure.checkToken": secureCheckToken,
+
+	"users.get":              usersGet,
+	"users.search":           usersSearch,
+	"users.getFollowers":     usersGetFollowers,
+	"users.getSubscriptions": usersGetSubscriptions,
+
+	"utils.resolveScreenName": utilsResolveScreenName,
+
+	"friends.get":    friendsGet,
+	"friends.delete": friendsDelete,
+
+	"wall.get":     wallGet,
+	"wall.getById": wallGetByID,
+	"wall.search":  wallSearch,
+	"wall.post":    wallPost,
+	"wall.edit":    wallEdit,
+	"wall.delete":  wallDelete,
+	"wall.restore": wallRestore,
+	"wall.pin":     wallPin,
+	"wall.unpin":   wallUnpin,
+	"wall.repost":  wallRepost,
+
+	"photos.getAlbums":     photosGetAlbums,
+	"photos.get":           photosGet,
+	"photos.getUserPhotos": photosGetUserPhotos,
+	"photos.delete":        photosDelete,
+	"photos.deleteAlbum":   photosDeleteAlbum,
+	"photos.getTags":       photosGetTags,
+	"photos.removeTag":     photosRemoveTag,
+
+	"messages.getDialogs":   messagesGetDialogs,
+	"messages.getHistory":   messagesGetHistory,
+	"messages.delete":       messagesDelete,
+	"messages.deleteDialog": messagesDeleteDialog,
+
+	"fave.getPosts":  faveGetPosts,
+	"fave.getPhotos": faveGetPhotos,
+	"fave.getVideos": faveGetVideos,
+
+	"likes.delete": likesDelete,
+}
+
+func accountGetAppPermissions(s *Server, user int, p params) (response, error) {
+	return number(int(s.scopes[p.get("access_token")])), nil
+}
+
+func secureCheckToken(s *Server, user int, p params) (response, error) {
+	owner, ok := s.tokens[p.get("token")]
+	if !ok {
+		return nil, &vk.Error{Code: vk.ErrAccessDenied, Msg: "invalid token"}
+	}
+	return func(w *jwriter.Writer) {
+		w.RawString(`{"success":1,"user_id":`)
+		w.Int(owner)
+		w.RawString(`,"date":0,"expire":0}`)
+	}, nil
+}
+
+func usersGet(s *Server, user int, p params) (response, error) {
+	ids := p.ints("user_ids")
+	if len(ids) == 0 {
+		ids = []int{user}
+	}
+	var users []vk.User
+	for _, id := range ids {
+		if u, ok := s.user(id); ok {
+			users = append(users, u)
+		}
+	}
+	return func(w *jwriter.Writer) {
+		w.RawByte('[')
+		for i, u := range users {
+			if i > 0 {
+				w.RawByte(',')
+			}
+			u.MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}, nil
+}
+
+// usersSearch matches query against first and last names.
+func usersSearch(s *Server, user int, p params) (response, error) {
+	q := strings.ToLower(p.get("q"))
+	var found []vk.User
+	for _, u := range s.state.Users {
+		name := strings.ToLower(u.FirstName + " " + u.LastName)
+		if strings.Contains(name, q) {
+			found = append(found, u)
+		}
+	}
+	lo, hi := p.page(len(found), 20, 1000)
+	return marshal(vk.Users{
+		Count: len(found),
+		Items: found[lo:hi],
+	}), nil
+}
+
+func usersGetFollowers(s *Server, user int, p params) (response, error) {
+	owner := p.int("user_id", user)
+	ids := s.state.Followers[owner]
+	lo, hi := p.page(len(ids), 100, 1000)
+	list := vk.Users{
+		Count: len(ids),
+	}
+	for _, id := range ids[lo:hi] {
+		if u, ok := s.user(id); ok {
+			list.Items = append(list.Items, u)
+		}
+	}
+	return marshal(list), nil
+}
+
+func usersGetSubscriptions(s *Server, user int, p params) (response, error) {
+	follower := p.int("user_id", user)
+	var ids []int
+	for id, followers := range s.state.Followers {
+		for _, f := range followers {
+			if f == follower {
+				ids = append(ids, id)
+				break
+			}
+		}
+	}
+	sort.Ints(ids)
+	return func(w *jwriter.Writer) {
+		w.RawString(`{"users":{"count":`)
+		w.Int(len(ids))
+		w.RawString(`,"items":[`)
+		for i, id := range ids {
+			if i > 0 {
+				w.RawByte(',')
+			}
+			w.Int(id)
+		}
+		w.RawString(`]},"groups":{"count":0,"items":[]}}`)
+	}, nil
+}
+
+func utilsResolveScreenName(s *Server, user int, p params) (response, error) {
+	name := p.get("screen_name")
+	for _, u := range s.state.Users {
+		if u.ScreenName == name || u.Domain == name || "id"+strconv.Itoa(u.ID) == name {
+			return func(w *jwriter.Writer) {
+				w.RawString(`{"type":"user","object_id":`)
+				w.Int(u.ID)
+				w.RawByte('}')
+			}, nil
+		}
+	}
+	return func(w *jwriter.Writer) {
+		w.RawString(`[]`)
+	}, nil
+}
+
+func friendsGet(s *Server, user int, p params) (response, error) {
+	owner := p.int("user_id", user)
+	ids := s.state.Friends[owner]
+	lo, hi := p.page(len(ids), 5000, 5000)
+	ids = ids[lo:hi]
+
+	if p.get("fields") == "" {
+		return func(w *jwriter.Writer) {
+			w.RawString(`{"count":`)
+			w.Int(len(s.state.Friends[owner]))
+			w.RawString(`,"items":[`)
+			for i, id := range ids {
+				if i > 0 {
+					w.RawByte(',')
+				}
+				w.Int(id)
+			}
+			w.RawString(`]}`)
+		}, nil
+	}
+	list := vk.Users{
+		Count: len(s.state.Friends[owner]),
+	}
+	for _, id := range ids {
+		if u, ok := s.user(id); ok {
+			list.Items = append(list.Items, u)
+		}
+	}
+	return marshal(list), nil
+}
+
+func friendsDelete(s *Server, user int, p params) (response, error) {
+	id := p.int("user_id", 0)
+	if !removeInt(s.state.Friends, user, id) {
+		return nil, &vk.Error{Code: vk.ErrNotFound}
+	}
+	removeInt(s.state.Friends, id, user)
+	return func(w *jwriter.Writer) {
+		w.RawString(`{"success":1,"friend_deleted":1}`)
+	}, nil
+}
+
+func wallGet(s *Server, user int, p params) (response, error) {
+	owner := s.wallOwner(user, p)
+	filter := p.get("filter")
+	var posts []vk.Post
+	for _, post := range s.state.Posts {
+		if post.OwnerID != owner {
+			continue
+		}
+		switch {
+		case filter == "owner" && post.FromID != owner:
+			continue
+		case filter == "others" && post.FromID == owner:
+			continue
+		}
+		posts = append(posts, post)
+	}
+	lo, hi := p.page(len(posts), 20, 100)
+	return s.posts(p, len(posts), posts[lo:hi]), nil
+}
+
+func wallGetByID(s *Server, user int, p params) (response, error) {
+	var posts []vk.Post
+	for _, ref := range strings.Split(p.get("posts"), ",") {
+		var owner, id int
+		if i := strings.IndexByte(ref, '_'); i > 0 {
+			owner, _ = strconv.Atoi(ref[:i])
+			id, _ = strconv.Atoi(ref[i+1:])
+		}
+		if i, ok := s.post(owner, id); ok {
+			posts = append(posts, s.state.Posts[i])
+		}
+	}
+	if p.get("extended") != "1" {
+		return func(w *jwriter.Writer) {
+			w.RawByte('[')
+			for i, post := range posts {
+				if i > 0 {
+					w.RawByte(',')
+				}
+				post.MarshalEasyJSON(w)
+			}
+			w.RawByte(']')
+		}, nil
+	}
+	return s.posts(p, len(posts), posts), nil
+}
+
+// wallSearch matches query against post texts.
+func wallSearch(s *Server, user int, p params) (response, error) {
+	if p.get("filter") != "" {
+		return nil, &vk.Error{
+			Code: vk.ErrInsufficientParameters,
+			Msg:  "filter is not supported",
+		}
+	}
+	owner := s.wallOwner(user, p)
+	query := strings.ToLower(p.get("query"))
+	ownersOnly := p.get("owners_only") == "1"
+	var posts []vk.Post
+	for _, post := range s.state.Posts {
+		if post.OwnerID != owner || (ownersOnly && post.FromID != owner) {
+			continue
+		}
+		if strings.Contains(strings.ToLower(post.Text), query) {
+			posts = append(posts, post)
+		}
+	}
+	lo, hi := p.page(len(posts), 20, 100)
+	return s.posts(p, len(posts), posts[lo:hi]), nil
+}
+
+func wallPost(s *Server, user int, p params) (response, error) {
+	owner := p.int("owner_id", user)
+	id := 1
+	for _, post := range s.state.Posts {
+		if post.OwnerID == owner && post.ID >= id {
+			id = post.ID + 1
+		}
+	}
+	post := vk.Post{
+		ID:      id,
+		OwnerID: owner,
+		FromID:  user,
+		Date:    vk.UnixTime{Time: time.Now()},
+	}
+	if p.get("from_group") == "1" && owner < 0 {
+		post.FromID = owner
+	}
+	if date := p.int("publish_date", 0); date > 0 {
+		post.Date = vk.UnixTime{Time: time.Unix(int64(date), 0)}
+		post.PostType = "postpone"
+	}
+	editPost(&post, p)
+	// Recent posts go first.
+	s.state.Posts = append([]vk.Post{post}, s.state.Posts...)
+	return postID(id), nil
+}
+
+func wallEdit(s *Server, user int, p params) (response, error) {
+	owner := p.int("owner_id", user)
+	id := p.int("post_id", 0)
+	i, ok := s.post(owner, id)
+	if !ok {
+		return nil, &vk.Error{Code: vk.ErrNotFound}
+	}
+	if s.state.Posts[i].FromID != user {
+		return nil, &vk.Error{Code: vk.ErrAccessDenied}
+	}
+	editPost(&s.state.Posts[i], p)
+	return postID(id), nil
+}
+
+func editPost(post *vk.Post, p params) {
+	post.Text = p.get("message")
+	if v := p.get("friends_only"); v != "" {
+		post.FriendsOnly = v == "1"
+	}
+	if v := p.get("mark_as_ads"); v != "" {
+		post.MarkedAsAds = v == "1"
+	}
+	post.Attachments = nil
+	for _, id := range strings.Split(p.get("attachments"), ",") {
+		if id != "" {
+			post.Attachments = append(post.Attachments, attachment(id))
+		}
+	}
+}
+
+// attachment returns attachment described by id like "photo1_2" or by url.
+func attachment(id string) vk.Attachment {
+	if strings.Contains(id, "://") {
+		return vk.Attachment{
+			Type: vk.AttachmentLink,
+			Link: &vk.Link{URL: id},
+		}
+	}
+	i := strings.IndexAny(id, "-0123456789")
+	if i < 0 {
+		return vk.Attachment{Type: vk.AttachmentType(id)}
+	}
+	a := vk.Attachment{
+		Type: vk.AttachmentType(id[:i]),
+	}
+	var owner, obj int
+	if j := strings.IndexByte(id[i:], '_'); j > 0 {
+		owner, _ = strconv.Atoi(id[i : i+j])
+		obj, _ = strconv.Atoi(id[i+j+1:])
+	}
+	switch a.Type {
+	case vk.AttachmentPhoto:
+		a.Photo = &vk.Photo{ID: obj, OwnerID: owner}
+	case vk.AttachmentVideo:
+		a.Video = &vk.Video{ID: obj, OwnerID: owner}
+	case vk.AttachmentDoc:
+		a.Doc = &vk.Doc{ID: obj, OwnerID: owner}
+	case vk.AttachmentAudio:
+		a.Audio = &vk.Audio{ID: obj, OwnerID: owner}
+	}
+	return a
+}
+
+func wallDelete(s *Server, user int, p params) (response, error) {
+	owner := p.int("owner_id", user)
+	id := p.int("post_id", 0)
+	i, ok := s.post(owner, id)
+	if !ok {
+		return nil, &vk.Error{Code: vk.ErrNotFound}
+	}
+	post := s.state.Posts[i]
+	if owner != user && post.FromID != user {
+		return nil, &vk.Error{Code: vk.ErrAccessDenied}
+	}
+	s.state.Posts = append(s.state.Posts[:i], s.state.Posts[i+1:]...)
+	s.deleted = append(s.deleted, post)
+	return number(1), nil
+}
+
+func wallRestore(s *Server, user int, p params) (response, error) {
+	owner := p.int("owner_id", user)
+	id := p.int("post_id", 0)
+	i := -1
+	for j, post := range s.deleted {
+		if post.OwnerID == owner && post.ID == id {
+			i = j
+		}
+	}
+	if i < 0 {
+		return nil, &vk.Error{Code: vk.ErrNotFound}
+	}
+	post := s.deleted[i]
+	s.deleted = append(s.deleted[:i], s.deleted[i+1:]...)
+	s.state.Posts = append(s.state.Posts, post)
+	sort.SliceStable(s.state.Posts, func(i, j int) bool {
+		return s.state.Posts[i].Date.After(s.state.Posts[j].Date.Time)
+	})
+	return number(1), nil
+}
+
+func wallPin(s *Server, user int, p params) (response, error) {
+	return pinPost(s, user, p, true)
+}
+
+func wallUnpin(s *Server, user int, p params) (response, error) {
+	return pinPost(s, user, p, false)
+}
+
+func pinPost(s *Server, user int, p params, pin bool) (response, error) {
+	owner := p.int("owner_id", user)
+	id := p.int("post_id", 0)
+	i, ok := s.post(owner, id)
+	if !ok {
+		return nil, &vk.Error{Code: vk.ErrNotFound}
+	}
+	if owner != user {
+		return nil, &vk.Error{Code: vk.ErrAccessDenied}
+	}
+	for j := range s.state.Posts {
+		if pin && s.state.Posts[j].OwnerID == owner {
+			s.state.Posts[j].IsPinned = false
+		}
+	}
+	s.state.Posts[i].IsPinned = vk.BoolInt(pin)
+	return number(1), nil
+}
+
+func wallRepost(s *Server, user int, p params) (response, error) {
+	var owner, id int
+	if ref := strings.TrimPrefix(p.get("object"), "wall"); ref != "" {
+		if i := strings.IndexByte(ref, '_'); i > 0 {
+			owner, _ = strconv.Atoi(ref[:i])
+			id, _ = strconv.Atoi(ref[i+1:])
+		}
+	}
+	i, ok := s.post(owner, id)
+	if !ok {
+		return nil, &vk.Error{Code: vk.ErrNotFound}
+	}
+	orig := s.state.Posts[i]
+	s.state.Posts[i].Reposts.Count++
+
+	wall := user
+	if group := p.int("group_id", 0); group > 0 {
+		wall = -group
+	}
+	p.Set("owner_id", strconv.Itoa(wall))
+	resp, err := wallPost(s, user, p)
+	if err != nil {
+		return nil, err
+	}
+	s.state.Posts[0].CopyHistory = []vk.Post{orig}
+	return resp, nil
+}
+
+// wallOwner returns owner of the wall requested by owner_id or domain
+// parameters.
+func (s *Server) wallOwner(user int, p params) int {
+	if domain := p.get("domain"); domain != "" && p.get("owner_id") == "" {
+		for _, u := range s.state.Users {
+			if u.ScreenName == domain || u.Domain == domain {
+				return u.ID
+			}
+		}
+		return 0
+	}
+	return p.int("owner_id", user)
+}
+
+// post returns index of the post in state.
+func (s *Server) post(owner, id int) (int, bool) {
+	for i, post := range s.state.Posts {
+		if post.OwnerID == owner && post.ID == id {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// posts returns list of posts with profiles of their authors if extended
+// parameter is set.
+func (s *Server) posts(p params, count int, items []vk.Post) response {
+	list := vk.Posts{
+		Count: count,
+		Items: items,
+	}
+	if p.get("extended") == "1" {
+		seen := make(map[int]bool)
+		for _, post := range items {
+			if post.FromID <= 0 || seen[post.FromID] {
+				continue
+			}
+			seen[post.FromID] = true
+			if u, ok := s.user(post.FromID); ok {
+				list.Profiles = append(list.Profiles, u)
+			}
+		}
+	}
+	return marshal(list)
+}
+
+func postID(id int) response {
+	return func(w *jwriter.Writer) {
+		w.RawString(`{"post_id":`)
+		w.Int(id)
+		w.RawByte('}')
+	}
+}
+
+func photosGetAlbums(s *Server, user int, p params) (response, error) {
+	owner := p.int("owner_id", user)
+	var albums []vk.PhotoAlbum
+	for _, album := range s.state.Albums {
+		if album.OwnerID == owner {
+			albums = append(albums, album)
+		}
+	}
+	return marshal(vk.PhotoAlbums{
+		Count: len(albums),
+		Items: albums,
+	}), nil
+}
+
+func photosGet(s *Server, user int, p params) (response, error) {
+	owner := p.int("owner_id", user)
+	var album int
+	switch a := p.get("album_id"); a {
+	case "wall":
+		album = AlbumWall
+	case "profile":
+		album = AlbumProfile
+	case "saved":
+		if owner != user {
+			return nil, &vk.Error{Code: vk.ErrAccessDeniedForAlbum}
+		}
+		album = AlbumSaved
+	default:
+		album, _ = strconv.Atoi(a)
+	}
+	var photos []vk.Photo
+	for _, photo := range s.state.Photos {
+		if photo.OwnerID == owner && photo.AlbumID == album {
+			photos = append(photos, photo)
+		}
+	}
+	lo, hi := p.page(len(photos), 50, 1000)
+	return marshal(vk.Photos{
+		Count: len(photos),
+		Items: photos[lo:hi],
+	}), nil
+}
+
+func photosGetUserPhotos(s *Server, user int, p params) (response, error) {
+	id := p.int("user_id", user)
+	var photos []vk.Photo
+	for _, photo := range s.state.Photos {
+		for _, tag := range s.state.Tags[photo.ID] {
+			if tag.UserID == id {
+				photos = append(photos, photo)
+				break
+			}
+		}
+	}
+	lo, hi := p.page(len(photos), 20, 1000)
+	return marshal(vk.Photos{
+		Count: len(photos),
+		Items: photos[lo:hi],
+	}), nil
+}
+
+func photosDelete(s *Server, user int, p params) (response, error) {
+	owner := p.int("owner_id", user)
+	id := p.int("photo_id", 0)
+	for i, photo := range s.state.Photos {
+		if photo.OwnerID != owner || photo.ID != id {
+			continue
+		}
+		if owner != user {
+			return nil, &vk.Error{Code: vk.ErrAccessDenied}
+		}
+		s.state.Photos = append(s.state.Photos[:i], s.state.Photos[i+1:]...)
+		delete(s.state.Tags, id)
+		return number(1), nil
+	}
+	return nil, &vk.Error{Code: vk.ErrNotFound}
+}
+
+func photosDeleteAlbum(s *Server, user int, p params) (response, error) {
+	id := p.int("album_id", 0)
+	for i, album := range s.state.Albums {
+		if album.ID != id || album.OwnerID != user {
+			continue
+		}
+		s.state.Albums = append(s.state.Albums[:i], s.state.Albums[i+1:]...)
+		photos := s.state.Photos[:0]
+		for _, photo := range s.state.Photos {
+			if photo.OwnerID == user && photo.AlbumID == id {
+				continue
+			}
+			photos = append(photos, photo)
+		}
+		s.state.Photos = photos
+		return number(1), nil
+	}
+	return nil, &vk.Error{Code: vk.ErrAccessDeniedForAlbum}
+}
+
+func photosGetTags(s *Server, user int, p params) (response, error) {
+	tags := s.state.Tags[p.int("photo_id", 0)]
+	return func(w *jwriter.Writer) {
+		w.RawByte('[')
+		for i, tag := range tags {
+			if i > 0 {
+				w.RawByte(',')
+			}
+			tag.MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}, nil
+}
+
+func photosRemoveTag(s *Server, user int, p params) (response, error) {
+	photo := p.int("photo_id", 0)
+	id := p.int("tag_id", 0)
+	tags := s.state.Tags[photo]
+	for i, tag := range tags {
+		if tag.ID != id {
+			continue
+		}
+		if tag.UserID != user && p.int("owner_id", user) != user {
+			return nil, &vk.Error{Code: vk.ErrAccessDenied}
+		}
+		s.state.Tags[photo] = append(tags[:i], tags[i+1:]...)
+		return number(1), nil
+	}
+	return nil, &vk.Error{Code: vk.ErrNotFound}
+}
+
+func messagesGetDialogs(s *Server, user int, p params) (response, error) {
+	last := make(map[int]vk.Message)
+	for _, m := range s.state.Messages {
+		if prev, ok := last[m.UserID]; !ok || !prev.Date.After(m.Date.Time) {
+			last[m.UserID] = m
+		}
+	}
+	dialogs := make([]vk.Dialog, 0, len(last))
+	for _, m := range last {
+		dialogs = append(dialogs, vk.Dialog{Message: m})
+	}
+	sort.Slice(dialogs, func(i, j int) bool {
+		return dialogs[i].Message.Date.After(dialogs[j].Message.Date.Time)
+	})
+	lo, hi := p.page(len(dialogs), 20, 200)
+	return marshal(vk.Dialogs{
+		Count: len(dialogs),
+		Items: dialogs[lo:hi],
+	}), nil
+}
+
+func messagesGetHistory(s *Server, user int, p params) (response, error) {
+	peer := p.int("user_id", 0)
+	var history []vk.Message
+	for _, m := range s.state.Messages {
+		if m.UserID == peer {
+			history = append(history, m)
+		}
+	}
+	// Most recent messages go first unless rev is set.
+	sort.SliceStable(history, func(i, j int) bool {
+		if p.int("rev", 0) == 1 {
+			return history[i].Date.Before(history[j].Date.Time)
+		}
+		return history[i].Date.After(history[j].Date.Time)
+	})
+	lo, hi := p.page(len(history), 20, 200)
+	return marshal(vk.Messages{
+		Count: len(history),
+		Items: history[lo:hi],
+	}), nil
+}
+
+func messagesDelete(s *Server, user int, p params) (response, error) {
+	deleted := make(map[int]bool)
+	for _, id := range p.ints("message_ids") {
+		deleted[id] = false
+	}
+	messages := s.state.Messages[:0]
+	for _, m := range s.state.Messages {
+		if _, ok := deleted[m.ID]; ok {
+			deleted[m.ID] = true
+			continue
+		}
+		messages = append(messages, m)
+	}
+	s.state.Messages = messages
+
+	ids := make([]int, 0, len(deleted))
+	for id := range deleted {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return func(w *jwriter.Writer) {
+		w.RawByte('{')
+		for i, id := range ids {
+			if i > 0 {
+				w.RawByte(',')
+			}
+			w.String(strconv.Itoa(id))
+			w.RawByte(':')
+			if deleted[id] {
+				w.Int(1)
+			} else {
+				w.Int(0)
+			}
+		}
+		w.RawByte('}')
+	}, nil
+}
+
+func messagesDeleteDialog(s *Server, user int, p params) (response, error) {
+	peer := p.int("user_id", 0)
+	messages := s.state.Messages[:0]
+	for _, m := range s.state.Messages {
+		if m.UserID != peer {
+			messages = append(messages, m)
+		}
+	}
+	s.state.Messages = messages
+	return number(1), nil
+}
+
+func faveGetPosts(s *Server, user int, p params) (response, error) {
+	posts := s.state.FavePosts
+	lo, hi := p.page(len(posts), 50, 100)
+	return marshal(vk.Posts{
+		Count: len(posts),
+		Items: posts[lo:hi],
+	}), nil
+}
+
+func faveGetPhotos(s *Server, user int, p params) (response, error) {
+	photos := s.state.FavePhotos
+	lo, hi := p.page(len(photos), 50, 100)
+	return marshal(vk.Photos{
+		Count: len(photos),
+		Items: photos[lo:hi],
+	}), nil
+}
+
+func faveGetVideos(s *Server, user int, p params) (response, error) {
+	videos := s.state.FaveVideos
+	lo, hi := p.page(len(videos), 50, 100)
+	return marshal(vk.Videos{
+		Count: len(videos),
+		Items: videos[lo:hi],
+	}), nil
+}
+
+func likesDelete(s *Server, user int, p params) (response, error) {
+	var (
+		owner = p.int("owner_id", user)
+		id    = p.int("item_id", 0)
+		found bool
+	)
+	switch t := p.get("type"); t {
+	case "post":
+		s.state.FavePosts, found = removePost(s.state.FavePosts, owner, id)
+	case "photo":
+		s.state.FavePhotos, found = removePhoto(s.state.FavePhotos, owner, id)
+	case "video":
+		s.state.FaveVideos, found = removeVideo(s.state.FaveVideos, owner, id)
+	default:
+		return nil, &vk.Error{
+			Code: vk.ErrInsufficientParameters,
+			Msg:  "unknown type: " + strings.TrimSpace(t),
+		}
+	}
+	if !found {
+		return nil, &vk.Error{Code: vk.ErrNotFound}
+	}
+	return func(w *jwriter.Writer) {
+		w.RawString(`{"likes":0}`)
+	}, nil
+}
+
+func (s *Server) user(id int) (vk.User, bool) {
+	for _, u := range s.state.Users {
+		if u.ID == id {
+			return u, true
+		}
+	}
+	return vk.User{}, false
+}
+
+func removeInt(m map[int][]int, key, value int) bool {
+	list := m[key]
+	for i, v := range list {
+		if v == value {
+			m[key] = append(list[:i], list[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func removePost(posts []vk.Post, owner, id int) ([]vk.Post, bool) {
+	for i, post := range posts {
+		if post.OwnerID == owner && post.ID == id {
+			return append(posts[:i], posts[i+1:]...), true
+		}
+	}
+	return posts, false
+}
+
+func removePhoto(photos []vk.Photo, owner, id int) ([]vk.Photo, bool) {
+	for i, photo := range photos {
+		if photo.OwnerID == owner && photo.ID == id {
+			return append(photos[:i], photos[i+1:]...), true
+		}
+	}
+	return photos, false
+}
+
+func removeVideo(videos []vk.Video, owner, id int) ([]vk.Video, bool) {
+	for i, video := range videos {
+		if video.OwnerID == owner && video.ID == id {
+			return append(videos[:i], videos[i+1:]...), true
+		}
+	}
+	return videos, false
+}
@@ -0,0 +1,526 @@
+package vktest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mailru/easyjson"
+	"github.com/mailru/easyjson/jwriter"
+
+	"github.com/gobwas/vk"
+)
+
+// Album ids of system albums used by photos.get.
+const (
+	AlbumWall    = -7
+	AlbumProfile = -6
+	AlbumSaved   = -15
+)
+
+// AllScopes is a scope granted to tokens returned by Server.Token().
+const AllScopes vk.Scope = 1<<29 - 1
+
+// State is an in-memory model of the data served by Server.
+type State struct {
+	Users   []vk.User
+	Friends map[int][]int
+
+	// Followers maps user id to ids of its followers.
+	Followers map[int][]int
+
+	Posts []vk.Post
+
+	Albums []vk.PhotoAlbum
+	Photos []vk.Photo
+	Tags   map[int][]vk.Tag
+
+	// Messages holds private messages; UserID is the id of the dialog peer.
+	Messages []vk.Message
+
+	FavePosts  []vk.Post
+	FavePhotos []vk.Photo
+	FaveVideos []vk.Video
+}
+
+// Server is a fake VK API server.
+//
+// Every call must be made with a token registered by Token(); otherwise
+// ErrNoAuth is returned. Objects which do not belong to the token owner
+// could not be modified.
+type Server struct {
+	*httptest.Server
+
+	// RateLimit is a maximum number of calls per second. Calls above the
+	// limit fail with ErrRateLimitExceeded. Zero means no limit.
+	RateLimit int
+
+	// CaptchaKey is the answer for captcha challenges.
+	CaptchaKey string
+
+	mu       sync.Mutex
+	state    *State
+	accounts map[string]account
+	tokens   map[string]int
+	scopes   map[string]vk.Scope
+	faults   []*fault
+	calls    []time.Time
+	counter  int
+	log      []string
+
+	// deleted holds posts which could be restored by wall.restore.
+	deleted []vk.Post
+}
+
+type account struct {
+	password string
+	userID   int
+	code     string
+}
+
+type fault struct {
+	method string
+	code   vk.ErrorCode
+	times  int
+}
+
+type handler func(s *Server, user int, p params) (response, error)
+
+type response func(w *jwriter.Writer)
+
+// NewServer starts and returns a new Server serving given state.
+func NewServer(state *State) *Server {
+	if state == nil {
+		state = new(State)
+	}
+	if state.Friends == nil {
+		state.Friends = make(map[int][]int)
+	}
+	if state.Followers == nil {
+		state.Followers = make(map[int][]int)
+	}
+	if state.Tags == nil {
+		state.Tags = make(map[int][]vk.Tag)
+	}
+	s := &Server{
+		CaptchaKey: "captcha",
+
+		state:    state,
+		accounts: make(map[string]account),
+		tokens:   make(map[string]int),
+		scopes:   make(map[string]vk.Scope),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Client returns vk.Client which makes calls to s.
+func (s *Server) Client() *vk.Client {
+	return &vk.Client{
+		HTTPClient: s.Server.Client(),
+		BaseURL:    s.URL + "/method",
+		OAuthURL:   s.URL + "/oauth",
+	}
+}
+
+// Token registers and returns a new access token of given user with
+// AllScopes granted.
+func (s *Server) Token(userID int) *vk.AccessToken {
+	return s.TokenWithScope(userID, AllScopes)
+}
+
+// TokenWithScope registers and returns a new access token of given user with
+// given scope granted. Note that Server does not check permissions of calls
+// other than account.getAppPermissions.
+func (s *Server) TokenWithScope(userID int, scope vk.Scope) *vk.AccessToken {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.counter++
+	token := "token" + strconv.Itoa(s.counter)
+	s.tokens[token] = userID
+	s.scopes[token] = scope
+	return &vk.AccessToken{
+		Token:  token,
+		UserID: userID,
+		Scope:  scope,
+	}
+}
+
+// Account registers credentials of given user which are accepted by password
+// grant. If code is non-empty, two-factor validation with that code is
+// required.
+func (s *Server) Account(username, password string, userID int, code string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.accounts[username] = account{
+		password: password,
+		userID:   userID,
+		code:     code,
+	}
+}
+
+// Fail makes next n calls of the method fail with given error code. Empty
+// method matches every call. If code is ErrCaptchaRequired, call with correct
+// captcha answer passes without consuming the fault.
+func (s *Server) Fail(method string, code vk.ErrorCode, n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &fault{
+		method: method,
+		code:   code,
+		times:  n,
+	})
+}
+
+// Do calls f with the server state locked.
+func (s *Server) Do(f func(*State)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f(s.state)
+}
+
+// Calls returns names of methods called so far, including calls made within
+// execute.
+func (s *Server) Calls() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.log...)
+}
+
+func (s *Server) serveHTTP(rw http.ResponseWriter, req *http.Request) {
+	switch req.URL.Path {
+	case "/oauth/access_token":
+		s.serveAccessToken(rw, req)
+		return
+	case "/oauth/token":
+		s.serveDirect(rw, req)
+		return
+	}
+	if !strings.HasPrefix(req.URL.Path, "/method/") {
+		http.NotFound(rw, req)
+		return
+	}
+	if err := req.ParseForm(); err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var (
+		method = strings.TrimPrefix(req.URL.Path, "/method/")
+		p      = params(req.Form)
+		w      jwriter.Writer
+	)
+
+	s.mu.Lock()
+	resp, err := s.call(method, p, true)
+	s.mu.Unlock()
+
+	if err != nil {
+		writeError(&w, method, p, err)
+	} else {
+		w.RawString(`{"response":`)
+		resp(&w)
+		w.RawByte('}')
+	}
+	rw.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.DumpTo(rw)
+}
+
+// serveAccessToken serves client credentials flow. Issued service tokens
+// belong to user with zero id.
+func (s *Server) serveAccessToken(rw http.ResponseWriter, req *http.Request) {
+	var w jwriter.Writer
+	rw.Header().Set("Content-Type", "application/json; charset=utf-8")
+	if req.FormValue("grant_type") != "client_credentials" {
+		rw.WriteHeader(http.StatusBadRequest)
+		w.RawString(`{"error":"unsupported_grant_type"}`)
+		w.DumpTo(rw)
+		return
+	}
+	token := s.TokenWithScope(0, 0)
+	w.RawString(`{"access_token":`)
+	w.String(token.Token)
+	w.RawString(`,"expires_in":0}`)
+	w.DumpTo(rw)
+}
+
+// serveDirect serves password grant for accounts registered by Account().
+func (s *Server) serveDirect(rw http.ResponseWriter, req *http.Request) {
+	var w jwriter.Writer
+	rw.Header().Set("Content-Type", "application/json; charset=utf-8")
+	fail := func(status int, body string) {
+		rw.WriteHeader(status)
+		w.RawString(body)
+		w.DumpTo(rw)
+	}
+	if req.Method != "POST" {
+		// Credentials must not be sent within url.
+		fail(http.StatusMethodNotAllowed, `{"error":"invalid_request"}`)
+		return
+	}
+	if req.FormValue("grant_type") != "password" {
+		fail(http.StatusBadRequest, `{"error":"unsupported_grant_type"}`)
+		return
+	}
+	s.mu.Lock()
+	acc, ok := s.accounts[req.FormValue("username")]
+	s.mu.Unlock()
+	if !ok || acc.password != req.FormValue("password") {
+		fail(http.StatusUnauthorized, `{"error":"invalid_client",`+
+			`"error_description":"Username or password is incorrect"}`)
+		return
+	}
+	if acc.code != "" && acc.code != req.FormValue("code") {
+		if req.FormValue("2fa_supported") != "1" {
+			fail(http.StatusUnauthorized, `{"error":"invalid_request",`+
+				`"error_description":"2fa is not supported"}`)
+			return
+		}
+		fail(http.StatusUnauthorized, `{"error":"need_validation",`+
+			`"validation_type":"2fa_app","validation_sid":"sid",`+
+			`"phone_mask":"+7 *** *** ** 00"}`)
+		return
+	}
+	scope, _ := strconv.ParseUint(req.FormValue("scope"), 10, 64)
+	token := s.TokenWithScope(acc.userID, vk.Scope(scope))
+	w.RawString(`{"access_token":`)
+	w.String(token.Token)
+	w.RawString(`,"expires_in":0,"user_id":`)
+	w.Int(acc.userID)
+	w.RawByte('}')
+	w.DumpTo(rw)
+}
+
+func (s *Server) call(method string, p params, top bool) (response, error) {
+	s.log = append(s.log, method)
+
+	user, ok := s.tokens[p.get("access_token")]
+	if !ok {
+		return nil, &vk.Error{Code: vk.ErrNoAuth}
+	}
+	if top && s.limited() {
+		return nil, &vk.Error{Code: vk.ErrRateLimitExceeded}
+	}
+	if err := s.fault(method, p, top); err != nil {
+		return nil, err
+	}
+	if method == "execute" && top {
+		return s.execute(user, p)
+	}
+	h, ok := handlers[method]
+	if !ok {
+		return nil, &vk.Error{Code: vk.ErrBadMethod}
+	}
+	return h(s, user, p)
+}
+
+func (s *Server) limited() bool {
+	if s.RateLimit <= 0 {
+		return false
+	}
+	now := time.Now()
+	i := sort.Search(len(s.calls), func(i int) bool {
+		return now.Sub(s.calls[i]) < time.Second
+	})
+	s.calls = append(s.calls[i:], now)
+	return len(s.calls) > s.RateLimit
+}
+
+func (s *Server) fault(method string, p params, top bool) error {
+	for i, f := range s.faults {
+		if f.method != method && (f.method != "" || !top) {
+			continue
+		}
+		if f.code == vk.ErrCaptchaRequired && p.get("captcha_key") == s.CaptchaKey {
+			continue
+		}
+		if f.times--; f.times <= 0 {
+			s.faults = append(s.faults[:i], s.faults[i+1:]...)
+		}
+		err := &vk.Error{Code: f.code}
+		if f.code == vk.ErrCaptchaRequired {
+			err.CaptchaSID = strconv.Itoa(len(s.log))
+			err.CaptchaImg = s.URL + "/captcha.jpg?sid=" + err.CaptchaSID
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *Server) execute(user int, p params) (response, error) {
+	calls, err := parseExecute(p.get("code"))
+	if err != nil {
+		return nil, &vk.Error{Code: vk.ErrExecuteCompile, Msg: err.Error()}
+	}
+	var (
+		results []response
+		errs    []vk.ExecuteError
+	)
+	for _, call := range calls {
+		call.params.Set("access_token", p.get("access_token"))
+		resp, err := s.call(call.method, call.params, false)
+		if err != nil {
+			results = append(results, func(w *jwriter.Writer) {
+				w.Bool(false)
+			})
+			code, _ := vk.ErrorCodeOf(err)
+			errs = append(errs, vk.ExecuteError{
+				Method: call.method,
+				Code:   code,
+				Msg:    code.String(),
+			})
+			continue
+		}
+		results = append(results, resp)
+	}
+	return func(w *jwriter.Writer) {
+		w.RawByte('[')
+		for i, r := range results {
+			if i > 0 {
+				w.RawByte(',')
+			}
+			r(w)
+		}
+		w.RawByte(']')
+		if len(errs) > 0 {
+			// Hack to put execute_errors next to response.
+			w.RawString(`,"execute_errors":[`)
+			for i, e := range errs {
+				if i > 0 {
+					w.RawByte(',')
+				}
+				e.MarshalEasyJSON(w)
+			}
+			w.RawByte(']')
+		}
+	}, nil
+}
+
+type executeCall struct {
+	method string
+	params params
+}
+
+// parseExecute parses the subset of VKScript which vk.Batcher produces.
+func parseExecute(code string) (calls []executeCall, err error) {
+	code = strings.TrimSpace(code)
+	if !strings.HasPrefix(code, "return [") || !strings.HasSuffix(code, "];") {
+		return nil, errBadCode
+	}
+	code = code[len("return [") : len(code)-len("];")]
+	for code != "" {
+		if !strings.HasPrefix(code, "API.") {
+			return nil, errBadCode
+		}
+		i := strings.IndexByte(code, '(')
+		if i == -1 {
+			return nil, errBadCode
+		}
+		method := code[len("API."):i]
+		code = code[i+1:]
+
+		var args map[string]string
+		dec := json.NewDecoder(strings.NewReader(code))
+		if err := dec.Decode(&args); err != nil {
+			return nil, err
+		}
+		code = strings.TrimSpace(code[dec.InputOffset():])
+		if !strings.HasPrefix(code, ")") {
+			return nil, errBadCode
+		}
+		code = strings.TrimPrefix(code[1:], ",")
+
+		p := make(params)
+		for key, value := range args {
+			p.Set(key, value)
+		}
+		calls = append(calls, executeCall{method, p})
+	}
+	return calls, nil
+}
+
+var errBadCode = &vk.Error{Code: vk.ErrExecuteCompile}
+
+func writeError(w *jwriter.Writer, method string, p params, err error) {
+	e, ok := err.(*vk.Error)
+	if !ok {
+		e = &vk.Error{
+			Code: vk.ErrInternalError,
+			Msg:  err.Error(),
+		}
+	}
+	if e.Msg == "" {
+		e.Msg = e.Code.String()
+	}
+	e.Params = append(e.Params, vk.RequestParam{Key: "method", Value: method})
+	for key := range p {
+		if key == "access_token" {
+			continue
+		}
+		e.Params = append(e.Params, vk.RequestParam{Key: key, Value: p.get(key)})
+	}
+	w.RawString(`{"error":`)
+	e.MarshalEasyJSON(w)
+	w.RawByte('}')
+}
+
+type params url.Values
+
+func (p params) get(key string) string {
+	return url.Values(p).Get(key)
+}
+
+func (p params) Set(key, value string) {
+	url.Values(p).Set(key, value)
+}
+
+func (p params) int(key string, def int) int {
+	n, err := strconv.Atoi(p.get(key))
+	if err != nil {
+		return def
+	}
+	return n
+}
+
+func (p params) ints(key string) (ret []int) {
+	for _, s := range strings.Split(p.get(key), ",") {
+		if n, err := strconv.Atoi(strings.TrimSpace(s)); err == nil {
+			ret = append(ret, n)
+		}
+	}
+	return ret
+}
+
+// page returns bounds of the requested page of n items.
+func (p params) page(n, defCount, maxCount int) (lo, hi int) {
+	count := p.int("count", defCount)
+	if count > maxCount {
+		count = maxCount
+	}
+	lo = p.int("offset", 0)
+	if lo > n {
+		lo = n
+	}
+	hi = lo + count
+	if hi > n {
+		hi = n
+	}
+	return lo, hi
+}
+
+func marshal(v easyjson.Marshaler) response {
+	return func(w *jwriter.Writer) {
+		v.MarshalEasyJSON(w)
+	}
+}
+
+func number(n int) response {
+	return func(w *jwriter.Writer) {
+		w.Int(n)
+	}
+}
@@ -0,0 +1,218 @@
+package vktest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"golang.org/x/time/rate"
+
+	"github.com/gobwas/vk"
+)
+
+type serverClient struct {
+	*vk.Client
+}
+
+func newServerClient(srv *Server) serverClient {
+	client := srv.Client()
+	client.Limiter = rate.NewLimiter(rate.Inf, 1)
+	return serverClient{client}
+}
+
+// Request makes a call and returns response stripped or API error.
+func (c serverClient) Request(ctx context.Context, method string, options ...vk.QueryOption) ([]byte, error) {
+	bts, err := c.Client.Request(ctx, method, options...)
+	if err != nil {
+		return nil, err
+	}
+	return vk.StripResponse(bts)
+}
+
+func TestServerAuth(t *testing.T) {
+	srv := NewServer(&State{
+		Users: []vk.User{{ID: 1}},
+	})
+	defer srv.Close()
+	client := newServerClient(srv)
+
+	_, err := client.Request(context.Background(), "users.get",
+		vk.WithAccessToken(&vk.AccessToken{Token: "unknown"}),
+	)
+	if !errors.Is(err, vk.ErrNoAuth) {
+		t.Errorf("unexpected error: %v; want %v", err, vk.ErrNoAuth)
+	}
+}
+
+func TestServerPagination(t *testing.T) {
+	state := &State{
+		Friends: map[int][]int{},
+	}
+	for id := 2; id < 12; id++ {
+		state.Friends[1] = append(state.Friends[1], id)
+	}
+	srv := NewServer(state)
+	defer srv.Close()
+	client := newServerClient(srv)
+	token := srv.Token(1)
+
+	for _, test := range []struct {
+		offset int
+		count  int
+		exp    []int
+	}{
+		{0, 3, []int{2, 3, 4}},
+		{3, 3, []int{5, 6, 7}},
+		{9, 3, []int{11}},
+		{20, 3, []int{}},
+	} {
+		bts, err := client.Request(context.Background(), "friends.get",
+			vk.WithAccessToken(token),
+			vk.WithNumber("offset", test.offset),
+			vk.WithNumber("count", test.count),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var page struct {
+			Count int   `json:"count"`
+			Items []int `json:"items"`
+		}
+		if err := json.Unmarshal(bts, &page); err != nil {
+			t.Fatal(err)
+		}
+		if page.Count != 10 {
+			t.Errorf("offset %d: got count %d; want 10", test.offset, page.Count)
+		}
+		if len(page.Items) != len(test.exp) {
+			t.Errorf("offset %d: got items %v; want %v", test.offset, page.Items, test.exp)
+			continue
+		}
+		for i := range page.Items {
+			if page.Items[i] != test.exp[i] {
+				t.Errorf("offset %d: got items %v; want %v", test.offset, page.Items, test.exp)
+				break
+			}
+		}
+	}
+}
+
+func TestServerFail(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		code    vk.ErrorCode
+		captcha string
+		errs    int
+	}{
+		{
+			name: "rate limit",
+			code: vk.ErrRateLimitExceeded,
+			errs: 2,
+		},
+		{
+			name: "permission",
+			code: vk.ErrAccessDenied,
+			errs: 2,
+		},
+		{
+			name: "captcha",
+			code: vk.ErrCaptchaRequired,
+			errs: 2,
+		},
+		{
+			name:    "captcha answered",
+			code:    vk.ErrCaptchaRequired,
+			captcha: "captcha",
+			errs:    0,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			srv := NewServer(&State{
+				Users: []vk.User{{ID: 1}},
+			})
+			defer srv.Close()
+			srv.Fail("users.get", test.code, 2)
+			client := newServerClient(srv)
+			token := srv.Token(1)
+
+			var errs int
+			for i := 0; i < 3; i++ {
+				_, err := client.Request(context.Background(), "users.get",
+					vk.WithAccessToken(token),
+					vk.WithParam("captcha_key", test.captcha),
+				)
+				if err == nil {
+					continue
+				}
+				errs++
+				if !errors.Is(err, test.code) {
+					t.Errorf("unexpected error: %v; want %v", err, test.code)
+				}
+				if sid, _, ok := vk.CaptchaError(err); ok != (test.code == vk.ErrCaptchaRequired) || (ok && sid == "") {
+					t.Errorf("unexpected captcha sid %q of error %v", sid, err)
+				}
+			}
+			if errs != test.errs {
+				t.Errorf("got %d errors; want %d", errs, test.errs)
+			}
+			if n := len(srv.Calls()); n != 3 {
+				t.Errorf("server logged %d calls; want 3", n)
+			}
+		})
+	}
+}
+
+func TestServerRateLimit(t *testing.T) {
+	srv := NewServer(&State{
+		Users: []vk.User{{ID: 1}},
+	})
+	defer srv.Close()
+	srv.RateLimit = 2
+	client := newServerClient(srv)
+	token := srv.Token(1)
+
+	var errs int
+	for i := 0; i < 3; i++ {
+		_, err := client.Request(context.Background(), "users.get",
+			vk.WithAccessToken(token),
+		)
+		if errors.Is(err, vk.ErrRateLimitExceeded) {
+			errs++
+		} else if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if errs != 1 {
+		t.Errorf("got %d rate limit errors; want 1", errs)
+	}
+}
+
+func TestServerOwnership(t *testing.T) {
+	srv := NewServer(&State{
+		Users: []vk.User{{ID: 1}, {ID: 2}, {ID: 3}},
+		Posts: []vk.Post{
+			{ID: 1, OwnerID: 1, FromID: 2},
+		},
+	})
+	defer srv.Close()
+	client := newServerClient(srv)
+
+	for _, test := range []struct {
+		user int
+		err  error
+	}{
+		{user: 3, err: vk.ErrAccessDenied},
+		{user: 2, err: nil},
+		{user: 1, err: vk.ErrNotFound},
+	} {
+		_, err := client.Request(context.Background(), "wall.delete",
+			vk.WithAccessToken(srv.Token(test.user)),
+			vk.WithNumber("owner_id", 1),
+			vk.WithNumber("post_id", 1),
+		)
+		if !errors.Is(err, test.err) {
+			t.Errorf("user %d: unexpected error: %v; want %v", test.user, err, test.err)
+		}
+	}
+}
@@ -0,0 +1,267 @@
+// Package vktest provides utilities for offline testing of code which uses vk
+// package.
+package vktest
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/gobwas/vk"
+)
+
+// RecordEnv is the name of environment variable which enables recording mode
+// in NewTransport().
+const RecordEnv = "VKTEST_RECORD"
+
+// NewClient returns vk.Client which sends requests with given transport.
+func NewClient(rt http.RoundTripper) *vk.Client {
+	return &vk.Client{
+		HTTPClient: &http.Client{
+			Transport: rt,
+		},
+	}
+}
+
+// Transport is a http.RoundTripper which could be closed to flush its state.
+type Transport interface {
+	http.RoundTripper
+	Close() error
+}
+
+// NewTransport returns Recorder writing to the fixture file at path if
+// RecordEnv environment variable is set. Otherwise it returns Replayer with
+// interactions loaded from that file.
+func NewTransport(path string) (Transport, error) {
+	if os.Getenv(RecordEnv) != "" {
+		return &Recorder{Path: path}, nil
+	}
+	return Load(path)
+}
+
+// Recorder is a http.RoundTripper which records every interaction made
+// through it. Sensitive parameters and tokens in responses are redacted.
+type Recorder struct {
+	// Transport is used to make real requests. If nil,
+	// http.DefaultTransport is used.
+	Transport http.RoundTripper
+
+	// Path is a fixture file path where interactions are written on Close().
+	Path string
+
+	mu           sync.Mutex
+	interactions []Interaction
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	params, err := requestParams(req)
+	if err != nil {
+		return nil, err
+	}
+	rt := r.Transport
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	r.mu.Lock()
+	r.interactions = append(r.interactions, Interaction{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Params: params,
+		Status: resp.StatusCode,
+		Body:   redactBody(string(body)),
+	})
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+// Interactions returns interactions recorded so far.
+func (r *Recorder) Interactions() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Interaction(nil), r.interactions...)
+}
+
+// Close writes recorded interactions to the fixture file.
+func (r *Recorder) Close() error {
+	file, err := os.Create(r.Path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return WriteInteractions(file, r.Interactions())
+}
+
+// Replayer is a http.RoundTripper which serves recorded interactions.
+//
+// Requests are matched by path and parameters; http method is ignored so
+// the same fixture serves both GET and POST requests. Interactions with the
+// same request are served in recorded order.
+type Replayer struct {
+	mu    sync.Mutex
+	queue map[string][]Interaction
+}
+
+// Load returns Replayer with interactions read from the fixture file.
+func Load(path string) (*Replayer, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	is, err := ReadInteractions(file)
+	if err != nil {
+		return nil, err
+	}
+	return NewReplayer(is), nil
+}
+
+// NewReplayer returns Replayer serving given interactions.
+func NewReplayer(is []Interaction) *Replayer {
+	r := &Replayer{
+		queue: make(map[string][]Interaction),
+	}
+	for _, i := range is {
+		key := i.Path + "?" + i.Params
+		r.queue[key] = append(r.queue[key], i)
+	}
+	return r
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	params, err := requestParams(req)
+	if err != nil {
+		return nil, err
+	}
+	key := req.URL.Path + "?" + params
+
+	r.mu.Lock()
+	is := r.queue[key]
+	if len(is) == 0 {
+		r.mu.Unlock()
+		return nil, fmt.Errorf("vktest: no recorded interaction for %s", key)
+	}
+	i := is[0]
+	r.queue[key] = is[1:]
+	r.mu.Unlock()
+
+	return &http.Response{
+		Status:     fmt.Sprintf("%d %s", i.Status, http.StatusText(i.Status)),
+		StatusCode: i.Status,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header: http.Header{
+			"Content-Type": []string{"application/json; charset=utf-8"},
+		},
+		Body:          ioutil.NopCloser(strings.NewReader(i.Body)),
+		ContentLength: int64(len(i.Body)),
+		Request:       req,
+	}, nil
+}
+
+// Pending returns number of interactions which were not served yet.
+func (r *Replayer) Pending() (n int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, is := range r.queue {
+		n += len(is)
+	}
+	return n
+}
+
+// Close returns error if some of interactions were not served.
+func (r *Replayer) Close() error {
+	if n := r.Pending(); n > 0 {
+		return fmt.Errorf("vktest: %d interactions were not replayed", n)
+	}
+	return nil
+}
+
+// WriteInteractions writes interactions to w as JSON lines.
+func WriteInteractions(w io.Writer, is []Interaction) error {
+	buf := bufio.NewWriter(w)
+	for _, i := range is {
+		bts, err := i.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		buf.Write(bts)
+		buf.WriteByte('\n')
+	}
+	return buf.Flush()
+}
+
+// ReadInteractions reads interactions written by WriteInteractions().
+func ReadInteractions(r io.Reader) (is []Interaction, err error) {
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 64<<20)
+	for s.Scan() {
+		line := bytes.TrimSpace(s.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var i Interaction
+		if err := i.UnmarshalJSON(line); err != nil {
+			return nil, err
+		}
+		is = append(is, i)
+	}
+	return is, s.Err()
+}
+
+// requestParams returns redacted and encoded parameters of the request,
+// both from url and form body.
+func requestParams(req *http.Request) (string, error) {
+	params := make(url.Values)
+	for key, values := range req.URL.Query() {
+		params[key] = values
+	}
+	if req.Body != nil && req.Method == "POST" {
+		body, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return "", err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return "", err
+		}
+		for key, values := range form {
+			params[key] = append(params[key], values...)
+		}
+	}
+	return vk.RedactParams(params).Encode(), nil
+}
+
+var tokenField = regexp.MustCompile(`"access_token(_\d+)?"\s*:\s*"[^"]*"`)
+
+func redactBody(body string) string {
+	return tokenField.ReplaceAllStringFunc(body, func(s string) string {
+		i := strings.Index(s[1:], `"`) + 2
+		return s[:i] + `:"REDACTED"`
+	})
+}
@@ -0,0 +1,149 @@
+package vktest
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/time/rate"
+
+	"github.com/gobwas/vk"
+)
+
+func TestRecordReplay(t *testing.T) {
+	srv := NewServer(&State{
+		Users: []vk.User{
+			{ID: 1, FirstName: "Pavel", LastName: "Durov"},
+			{ID: 2, FirstName: "Ivan", LastName: "Ivanov"},
+		},
+		Friends: map[int][]int{
+			1: {2},
+		},
+	})
+	defer srv.Close()
+
+	path := filepath.Join(t.TempDir(), "fixture.jsonl")
+	rec := &Recorder{
+		Transport: srv.Server.Client().Transport,
+		Path:      path,
+	}
+	client := NewClient(rec)
+	client.BaseURL = srv.URL + "/method"
+	client.Limiter = rate.NewLimiter(rate.Inf, 1)
+
+	token := srv.Token(1)
+	exp := calls(t, client, token)
+
+	if err := rec.Close(); err != nil {
+		t.Fatal(err)
+	}
+	for _, i := range rec.Interactions() {
+		if strings.Contains(i.Params, token.Token) {
+			t.Errorf("token is not redacted in params: %s", i.Params)
+		}
+	}
+
+	rep, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client = NewClient(rep)
+	client.BaseURL = "http://example.com/method"
+	client.Limiter = rate.NewLimiter(rate.Inf, 1)
+
+	// Replayed calls are made with other token to check that requests are
+	// matched without sensitive parameters.
+	act := calls(t, client, &vk.AccessToken{Token: "other"})
+	if !reflect.DeepEqual(act, exp) {
+		t.Errorf("unexpected replayed responses: %q; want %q", act, exp)
+	}
+	if err := rep.Close(); err != nil {
+		t.Error(err)
+	}
+
+	_, err = client.Request(context.Background(), "users.get")
+	if err == nil {
+		t.Errorf("want error on unrecorded request")
+	}
+}
+
+func calls(t *testing.T, client *vk.Client, token *vk.AccessToken) (ret []string) {
+	for _, method := range []string{
+		"users.get",
+		"friends.get",
+		"users.get",
+	} {
+		bts, err := client.Request(context.Background(), method,
+			vk.WithAccessToken(token),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ret = append(ret, string(bts))
+	}
+	return ret
+}
+
+func TestReplayFixture(t *testing.T) {
+	rt, err := NewTransport(filepath.Join("testdata", "users.get.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := rt.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	if _, ok := rt.(*Recorder); ok {
+		t.Skip("recording is not supported by this test")
+	}
+	users := vk.UsersService{
+		Client: NewClient(rt),
+		Token:  &vk.AccessToken{Token: "token"},
+	}
+	ctx := context.Background()
+
+	list, err := users.Get(ctx, []int{1, 6492}, vk.UserFieldScreenName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, u := range list {
+		names = append(names, u.ScreenName)
+	}
+	if exp := []string{"durov", "andrew"}; !reflect.DeepEqual(names, exp) {
+		t.Errorf("unexpected screen names: %q; want %q", names, exp)
+	}
+
+	_, err = users.Get(ctx, []int{0})
+	if !errors.Is(err, vk.ErrBadUserID) {
+		t.Errorf("unexpected error: %v; want %v", err, vk.ErrBadUserID)
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	for _, test := range []struct {
+		in  string
+		exp string
+	}{
+		{
+			in:  `{"access_token":"secret","user_id":1}`,
+			exp: `{"access_token":"REDACTED","user_id":1}`,
+		},
+		{
+			in:  `{"access_token_42": "secret"}`,
+			exp: `{"access_token_42":"REDACTED"}`,
+		},
+		{
+			in:  `{"response":1}`,
+			exp: `{"response":1}`,
+		},
+	} {
+		if act := redactBody(test.in); act != test.exp {
+			t.Errorf("redactBody(%s) = %s; want %s", test.in, act, test.exp)
+		}
+	}
+}
@@ -5,64 +5,65 @@ package vk
 type Posts struct {
 	Count int    `json:"count"`
 	Items []Post `json:"items"`
+
+	// Profiles and Groups are set for extended requests only.
+	Profiles []User  `json:"profiles,omitempty"`
+	Groups   []Group `json:"groups,omitempty"`
 }
 
 type Post struct {
-	ID           int    `json:"id"`
-	OwnerID      int    `json:"owner_id"`
-	FromID       int    `json:"from_id"`
-	CreatedBy    int    `json:"created_by"`
-	Date         int    `json:"date"`
-	Text         string `json:"text"`
-	ReplyOwnerID int    `json:"reply_owner_id"`
-	ReplyPostID  int    `json:"reply_post_id"`
-	FriendsOnly  int    `json:"friends_only"`
-	PostType     string `json:"post_type"`
-	CanPin       int    `json:"can_pin"`
-	CanDelete    int    `json:"can_delete"`
-	CanEdit      int    `json:"can_edit"`
-	IsPinned     int    `json:"is_pinned"`
-	MarkedAsAds  int    `json:"marked_as_ads"`
-	SignerID     int    `json:"signer_id"`
+	ID           int      `json:"id"`
+	OwnerID      int      `json:"owner_id"`
+	FromID       int      `json:"from_id"`
+	CreatedBy    int      `json:"created_by"`
+	Date         UnixTime `json:"date"`
+	Text         string   `json:"text"`
+	ReplyOwnerID int      `json:"reply_owner_id"`
+	ReplyPostID  int      `json:"reply_post_id"`
+	FriendsOnly  BoolInt  `json:"friends_only"`
+	PostType     string   `json:"post_type"`
+	CanPin       BoolInt  `json:"can_pin"`
+	CanDelete    BoolInt  `json:"can_delete"`
+	CanEdit      BoolInt  `json:"can_edit"`
+	IsPinned     BoolInt  `json:"is_pinned"`
+	MarkedAsAds  BoolInt  `json:"marked_as_ads"`
+	SignerID     int      `json:"signer_id"`
+
+	Comments    PostComments `json:"comments"`
+	Likes       PostLikes    `json:"likes"`
+	Reposts     PostReposts  `json:"reposts"`
+	Views       PostViews    `json:"views"`
+	PostSource  PostSource   `json:"post_source"`
+	Attachments []Attachment `json:"attachments"`
+	Geo         Geo          `json:"geo"`
+	CopyHistory []Post       `json:"copy_history"`
 
-	Comments    PostComments      `json:"comments"`
-	Likes       PostLikes         `json:"likes"`
-	Reposts     PostReposts       `json:"reposts"`
-	Views       PostViews         `json:"views"`
-	PostSource  PostSource        `json:"post_source"`
-	Attachments []PostAttachement `json:"attachments"`
-	Geo         Geo               `json:"geo"`
-	CopyHistory []Post            `json:"copy_history"`
+	// Extra holds fields unknown to Post.
+	Extra Extra `json:"-"`
 }
 
 type PostComments struct {
-	Count         int  `json:"count"`
-	CanPost       int  `json:"can_post"`
-	GroupsCanPost bool `json:"groups_can_post"`
+	Count         int     `json:"count"`
+	CanPost       BoolInt `json:"can_post"`
+	GroupsCanPost bool    `json:"groups_can_post"`
 }
 
 type PostLikes struct {
-	Count      int `json:"count"`
-	UserLikes  int `json:"user_likes"`
-	CanLike    int `json:"can_like"`
-	CanPublish int `json:"can_publish"`
+	Count      int     `json:"count"`
+	UserLikes  BoolInt `json:"user_likes"`
+	CanLike    BoolInt `json:"can_like"`
+	CanPublish BoolInt `json:"can_publish"`
 }
 
 type PostReposts struct {
-	Count        int `json:"count"`
-	UserReposted int `json:"user_reposted"`
+	Count        int     `json:"count"`
+	UserReposted BoolInt `json:"user_reposted"`
 }
 
 type PostViews struct {
 	Count int `json:"count"`
 }
 
-type PostAttachement struct {
-	//TODO
-	Type  string `json:"type"`
-	Photo Photo  `json:"photo"`
-}
-
 type PostSource struct {
 	Type     string `json:"type"`
 	Platform string `json:"platform"`
@@ -61,6 +61,52 @@ func easyjson783c1624DecodeGithubComGobwasVk(in *jlexer.Lexer, out *Posts) {
 				}
 				in.Delim(']')
 			}
+		case "profiles":
+			if in.IsNull() {
+				in.Skip()
+				out.Profiles = nil
+			} else {
+				in.Delim('[')
+				if out.Profiles == nil {
+					if !in.IsDelim(']') {
+						out.Profiles = make([]User, 0, 1)
+					} else {
+						out.Profiles = []User{}
+					}
+				} else {
+					out.Profiles = (out.Profiles)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 User
+					(v2).UnmarshalEasyJSON(in)
+					out.Profiles = append(out.Profiles, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "groups":
+			if in.IsNull() {
+				in.Skip()
+				out.Groups = nil
+			} else {
+				in.Delim('[')
+				if out.Groups == nil {
+					if !in.IsDelim(']') {
+						out.Groups = make([]Group, 0, 1)
+					} else {
+						out.Groups = []Group{}
+					}
+				} else {
+					out.Groups = (out.Groups)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 Group
+					(v3).UnmarshalEasyJSON(in)
+					out.Groups = append(out.Groups, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -97,11 +143,49 @@ func easyjson783c1624EncodeGithubComGobwasVk(out *jwriter.Writer, in Posts) {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v2, v3 := range in.Items {
-				if v2 > 0 {
+			for v4, v5 := range in.Items {
+				if v4 > 0 {
+					out.RawByte(',')
+				}
+				(v5).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Profiles) != 0 {
+		const prefix string = ",\"profiles\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v6, v7 := range in.Profiles {
+				if v6 > 0 {
 					out.RawByte(',')
 				}
-				(v3).MarshalEasyJSON(out)
+				(v7).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Groups) != 0 {
+		const prefix string = ",\"groups\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v8, v9 := range in.Groups {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				(v9).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -332,7 +416,7 @@ func easyjson783c1624DecodeGithubComGobwasVk3(in *jlexer.Lexer, out *PostReposts
 		case "count":
 			out.Count = int(in.Int())
 		case "user_reposted":
-			out.UserReposted = int(in.Int())
+			(out.UserReposted).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -365,7 +449,7 @@ func easyjson783c1624EncodeGithubComGobwasVk3(out *jwriter.Writer, in PostRepost
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.UserReposted))
+		(in.UserReposted).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
@@ -415,11 +499,11 @@ func easyjson783c1624DecodeGithubComGobwasVk4(in *jlexer.Lexer, out *PostLikes)
 		case "count":
 			out.Count = int(in.Int())
 		case "user_likes":
-			out.UserLikes = int(in.Int())
+			(out.UserLikes).UnmarshalEasyJSON(in)
 		case "can_like":
-			out.CanLike = int(in.Int())
+			(out.CanLike).UnmarshalEasyJSON(in)
 		case "can_publish":
-			out.CanPublish = int(in.Int())
+			(out.CanPublish).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -452,7 +536,7 @@ func easyjson783c1624EncodeGithubComGobwasVk4(out *jwriter.Writer, in PostLikes)
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.UserLikes))
+		(in.UserLikes).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"can_like\":"
@@ -462,7 +546,7 @@ func easyjson783c1624EncodeGithubComGobwasVk4(out *jwriter.Writer, in PostLikes)
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.CanLike))
+		(in.CanLike).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"can_publish\":"
@@ -472,7 +556,7 @@ func easyjson783c1624EncodeGithubComGobwasVk4(out *jwriter.Writer, in PostLikes)
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.CanPublish))
+		(in.CanPublish).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
@@ -522,7 +606,7 @@ func easyjson783c1624DecodeGithubComGobwasVk5(in *jlexer.Lexer, out *PostComment
 		case "count":
 			out.Count = int(in.Int())
 		case "can_post":
-			out.CanPost = int(in.Int())
+			(out.CanPost).UnmarshalEasyJSON(in)
 		case "groups_can_post":
 			out.GroupsCanPost = bool(in.Bool())
 		default:
@@ -557,7 +641,7 @@ func easyjson783c1624EncodeGithubComGobwasVk5(out *jwriter.Writer, in PostCommen
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.CanPost))
+		(in.CanPost).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"groups_can_post\":"
@@ -595,90 +679,7 @@ func (v *PostComments) UnmarshalJSON(data []byte) error {
 func (v *PostComments) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson783c1624DecodeGithubComGobwasVk5(l, v)
 }
-func easyjson783c1624DecodeGithubComGobwasVk6(in *jlexer.Lexer, out *PostAttachement) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeString()
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "type":
-			out.Type = string(in.String())
-		case "photo":
-			(out.Photo).UnmarshalEasyJSON(in)
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjson783c1624EncodeGithubComGobwasVk6(out *jwriter.Writer, in PostAttachement) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"type\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.Type))
-	}
-	{
-		const prefix string = ",\"photo\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		(in.Photo).MarshalEasyJSON(out)
-	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v PostAttachement) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson783c1624EncodeGithubComGobwasVk6(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PostAttachement) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson783c1624EncodeGithubComGobwasVk6(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *PostAttachement) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson783c1624DecodeGithubComGobwasVk6(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PostAttachement) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson783c1624DecodeGithubComGobwasVk6(l, v)
-}
-func easyjson783c1624DecodeGithubComGobwasVk7(in *jlexer.Lexer, out *Post) {
+func easyjson783c1624DecodeGithubComGobwasVk6(in *jlexer.Lexer, out *Post) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -706,7 +707,7 @@ func easyjson783c1624DecodeGithubComGobwasVk7(in *jlexer.Lexer, out *Post) {
 		case "created_by":
 			out.CreatedBy = int(in.Int())
 		case "date":
-			out.Date = int(in.Int())
+			(out.Date).UnmarshalEasyJSON(in)
 		case "text":
 			out.Text = string(in.String())
 		case "reply_owner_id":
@@ -714,19 +715,19 @@ func easyjson783c1624DecodeGithubComGobwasVk7(in *jlexer.Lexer, out *Post) {
 		case "reply_post_id":
 			out.ReplyPostID = int(in.Int())
 		case "friends_only":
-			out.FriendsOnly = int(in.Int())
+			(out.FriendsOnly).UnmarshalEasyJSON(in)
 		case "post_type":
 			out.PostType = string(in.String())
 		case "can_pin":
-			out.CanPin = int(in.Int())
+			(out.CanPin).UnmarshalEasyJSON(in)
 		case "can_delete":
-			out.CanDelete = int(in.Int())
+			(out.CanDelete).UnmarshalEasyJSON(in)
 		case "can_edit":
-			out.CanEdit = int(in.Int())
+			(out.CanEdit).UnmarshalEasyJSON(in)
 		case "is_pinned":
-			out.IsPinned = int(in.Int())
+			(out.IsPinned).UnmarshalEasyJSON(in)
 		case "marked_as_ads":
-			out.MarkedAsAds = int(in.Int())
+			(out.MarkedAsAds).UnmarshalEasyJSON(in)
 		case "signer_id":
 			out.SignerID = int(in.Int())
 		case "comments":
@@ -747,17 +748,17 @@ func easyjson783c1624DecodeGithubComGobwasVk7(in *jlexer.Lexer, out *Post) {
 				in.Delim('[')
 				if out.Attachments == nil {
 					if !in.IsDelim(']') {
-						out.Attachments = make([]PostAttachement, 0, 1)
+						out.Attachments = make([]Attachment, 0, 1)
 					} else {
-						out.Attachments = []PostAttachement{}
+						out.Attachments = []Attachment{}
 					}
 				} else {
 					out.Attachments = (out.Attachments)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v4 PostAttachement
-					(v4).UnmarshalEasyJSON(in)
-					out.Attachments = append(out.Attachments, v4)
+					var v10 Attachment
+					(v10).UnmarshalEasyJSON(in)
+					out.Attachments = append(out.Attachments, v10)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -780,15 +781,15 @@ func easyjson783c1624DecodeGithubComGobwasVk7(in *jlexer.Lexer, out *Post) {
 					out.CopyHistory = (out.CopyHistory)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v5 Post
-					(v5).UnmarshalEasyJSON(in)
-					out.CopyHistory = append(out.CopyHistory, v5)
+					var v11 Post
+					(v11).UnmarshalEasyJSON(in)
+					out.CopyHistory = append(out.CopyHistory, v11)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
 		default:
-			in.SkipRecursive()
+			out.UnmarshalUnknown(in, key)
 		}
 		in.WantComma()
 	}
@@ -797,7 +798,7 @@ func easyjson783c1624DecodeGithubComGobwasVk7(in *jlexer.Lexer, out *Post) {
 		in.Consumed()
 	}
 }
-func easyjson783c1624EncodeGithubComGobwasVk7(out *jwriter.Writer, in Post) {
+func easyjson783c1624EncodeGithubComGobwasVk6(out *jwriter.Writer, in Post) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -849,7 +850,7 @@ func easyjson783c1624EncodeGithubComGobwasVk7(out *jwriter.Writer, in Post) {
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.Date))
+		(in.Date).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"text\":"
@@ -889,7 +890,7 @@ func easyjson783c1624EncodeGithubComGobwasVk7(out *jwriter.Writer, in Post) {
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.FriendsOnly))
+		(in.FriendsOnly).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"post_type\":"
@@ -909,7 +910,7 @@ func easyjson783c1624EncodeGithubComGobwasVk7(out *jwriter.Writer, in Post) {
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.CanPin))
+		(in.CanPin).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"can_delete\":"
@@ -919,7 +920,7 @@ func easyjson783c1624EncodeGithubComGobwasVk7(out *jwriter.Writer, in Post) {
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.CanDelete))
+		(in.CanDelete).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"can_edit\":"
@@ -929,7 +930,7 @@ func easyjson783c1624EncodeGithubComGobwasVk7(out *jwriter.Writer, in Post) {
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.CanEdit))
+		(in.CanEdit).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"is_pinned\":"
@@ -939,7 +940,7 @@ func easyjson783c1624EncodeGithubComGobwasVk7(out *jwriter.Writer, in Post) {
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.IsPinned))
+		(in.IsPinned).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"marked_as_ads\":"
@@ -949,7 +950,7 @@ func easyjson783c1624EncodeGithubComGobwasVk7(out *jwriter.Writer, in Post) {
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.MarkedAsAds))
+		(in.MarkedAsAds).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"signer_id\":"
@@ -1023,11 +1024,11 @@ func easyjson783c1624EncodeGithubComGobwasVk7(out *jwriter.Writer, in Post) {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v6, v7 := range in.Attachments {
-				if v6 > 0 {
+			for v12, v13 := range in.Attachments {
+				if v12 > 0 {
 					out.RawByte(',')
 				}
-				(v7).MarshalEasyJSON(out)
+				(v13).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -1054,38 +1055,39 @@ func easyjson783c1624EncodeGithubComGobwasVk7(out *jwriter.Writer, in Post) {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v8, v9 := range in.CopyHistory {
-				if v8 > 0 {
+			for v14, v15 := range in.CopyHistory {
+				if v14 > 0 {
 					out.RawByte(',')
 				}
-				(v9).MarshalEasyJSON(out)
+				(v15).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
+	in.MarshalUnknowns(out, false)
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v Post) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson783c1624EncodeGithubComGobwasVk7(&w, v)
+	easyjson783c1624EncodeGithubComGobwasVk6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Post) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson783c1624EncodeGithubComGobwasVk7(w, v)
+	easyjson783c1624EncodeGithubComGobwasVk6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Post) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson783c1624DecodeGithubComGobwasVk7(&r, v)
+	easyjson783c1624DecodeGithubComGobwasVk6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Post) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson783c1624DecodeGithubComGobwasVk7(l, v)
+	easyjson783c1624DecodeGithubComGobwasVk6(l, v)
 }
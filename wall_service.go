@@ -0,0 +1,402 @@
+package vk
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/mailru/easyjson/jlexer"
+)
+
+// WallFilter selects posts returned by wall.get.
+type WallFilter string
+
+const (
+	WallFilterAll       WallFilter = "all"
+	WallFilterOwner     WallFilter = "owner"
+	WallFilterOthers    WallFilter = "others"
+	WallFilterPostponed WallFilter = "postponed"
+	WallFilterSuggests  WallFilter = "suggests"
+)
+
+// MaxPostsPerRequest is the maximum number of posts returned by a single
+// wall.get, wall.search or wall.getById call.
+const MaxPostsPerRequest = 100
+
+// PostRef identifies a post on some wall.
+type PostRef struct {
+	// OwnerID is an id of the wall owner; it is negative for communities.
+	// Zero means the token owner, except for GetByID() and Repost() which
+	// require explicit owner.
+	OwnerID int
+	ID      int
+}
+
+// String returns ref in "<owner_id>_<id>" form used by wall.getById.
+func (r PostRef) String() string {
+	return strconv.Itoa(r.OwnerID) + "_" + strconv.Itoa(r.ID)
+}
+
+func (r PostRef) options() []QueryOption {
+	ret := QueryOptions(
+		WithNumber("post_id", r.ID),
+	)
+	if r.OwnerID != 0 {
+		ret = append(ret, WithNumber("owner_id", r.OwnerID))
+	}
+	return ret
+}
+
+// Ref returns reference to p.
+func (p *Post) Ref() PostRef {
+	return PostRef{
+		OwnerID: p.OwnerID,
+		ID:      p.ID,
+	}
+}
+
+// WallQuery selects a wall and its posts.
+type WallQuery struct {
+	// OwnerID is an id of user or community (negative) which wall is
+	// requested. If both OwnerID and Domain are empty, the wall of the token
+	// owner is used.
+	OwnerID int
+	// Domain is a short name of the wall owner. It is used if OwnerID is
+	// zero.
+	Domain string
+
+	// Filter selects posts by their author. For wall.search it only
+	// matters if it is WallFilterOwner; other filters are not sent.
+	Filter WallFilter
+
+	// Extended makes methods return profiles and groups of post authors.
+	// Fields are requested for those profiles and groups.
+	Extended bool
+	Fields   []UserField
+}
+
+func (q WallQuery) options() []QueryOption {
+	ret := q.wall()
+	if q.Filter != "" {
+		ret = append(ret, WithParam("filter", string(q.Filter)))
+	}
+	return ret
+}
+
+// wall returns options selecting the wall without filter which is not
+// accepted by wall.search.
+func (q WallQuery) wall() []QueryOption {
+	var ret []QueryOption
+	switch {
+	case q.OwnerID != 0:
+		ret = append(ret, WithNumber("owner_id", q.OwnerID))
+	case q.Domain != "":
+		ret = append(ret, WithParam("domain", q.Domain))
+	}
+	return append(ret, withExtended(q.Extended, q.Fields))
+}
+
+// WallPost describes post to be published with wall.post or changed with
+// wall.edit.
+type WallPost struct {
+	// OwnerID is an id of user or community (negative) which wall the post
+	// is published on. Zero means the token owner. It is ignored by Edit().
+	OwnerID int
+
+	Message string
+
+	// Attachments is a list of objects in "<type><owner_id>_<id>" form (see
+	// AttachmentID) or a link url.
+	Attachments []string
+
+	// Flags are sent only if they are set. That is, Edit() does not clear
+	// flags of the post which are false in p.
+	FriendsOnly   bool
+	FromGroup     bool
+	Signed        bool
+	MarkAsAds     bool
+	CloseComments bool
+
+	// PublishDate schedules the post. Zero means the post is published
+	// immediately.
+	PublishDate time.Time
+
+	Coordinates Coordinates
+
+	// GUID prevents sending of the same post twice.
+	GUID string
+}
+
+func (p WallPost) options() []QueryOption {
+	ret := QueryOptions(
+		withFlag("friends_only", p.FriendsOnly),
+		withFlag("signed", p.Signed),
+		withFlag("mark_as_ads", p.MarkAsAds),
+		withFlag("close_comments", p.CloseComments),
+	)
+	if p.Message != "" {
+		ret = append(ret, WithParam("message", p.Message))
+	}
+	if len(p.Attachments) > 0 {
+		ret = append(ret, WithStrings("attachments", p.Attachments...))
+	}
+	if !p.PublishDate.IsZero() {
+		ret = append(ret, WithParam("publish_date",
+			strconv.FormatInt(p.PublishDate.Unix(), 10),
+		))
+	}
+	if !p.Coordinates.IsZero() {
+		ret = append(ret,
+			WithParam("lat", strconv.FormatFloat(p.Coordinates.Lat, 'f', -1, 64)),
+			WithParam("long", strconv.FormatFloat(p.Coordinates.Lon, 'f', -1, 64)),
+		)
+	}
+	if p.GUID != "" {
+		ret = append(ret, WithParam("guid", p.GUID))
+	}
+	return ret
+}
+
+// AttachmentID returns attachment in the form accepted by wall.post, e.g.
+// "photo100_200".
+func AttachmentID(t AttachmentType, ownerID, id int) string {
+	return string(t) + strconv.Itoa(ownerID) + "_" + strconv.Itoa(id)
+}
+
+// WallService provides wall.* methods.
+type WallService struct {
+	// Client is used to make calls. If nil, DefaultClient is used.
+	Client *Client
+
+	Token   *AccessToken
+	Limiter *rate.Limiter
+	Retry   RetryPolicy
+}
+
+// Iterator returns Iterator over wall.get pages. It could be used with
+// NewPager to process posts without fetching the whole wall.
+func (s *WallService) Iterator(q WallQuery) *Iterator {
+	return s.iterator("wall.get", q.options()...)
+}
+
+// Get returns all posts of the wall selected by q.
+func (s *WallService) Get(ctx context.Context, q WallQuery) (Posts, error) {
+	return s.collect(ctx, "wall.get", q.options()...)
+}
+
+// GetByID returns posts referred by refs. Refs are split into chunks of
+// MaxPostsPerRequest.
+func (s *WallService) GetByID(ctx context.Context, refs []PostRef, extended bool, fields ...UserField) (Posts, error) {
+	var ret Posts
+	for i := 0; i < len(refs); i += MaxPostsPerRequest {
+		sub := refs[i:]
+		if len(sub) > MaxPostsPerRequest {
+			sub = sub[:MaxPostsPerRequest]
+		}
+		strs := make([]string, len(sub))
+		for i, ref := range sub {
+			strs[i] = ref.String()
+		}
+		bts, err := s.caller("wall.getById").Call(ctx,
+			WithStrings("posts", strs...),
+			withExtended(extended, fields),
+		)
+		if err != nil {
+			return ret, err
+		}
+		page, err := parsePosts(bts)
+		if err != nil {
+			return ret, err
+		}
+		ret.merge(page)
+	}
+	ret.Count = len(ret.Items)
+	return ret, nil
+}
+
+// parsePosts parses either bare array of posts or extended response object.
+func parsePosts(p []byte) (ret Posts, err error) {
+	if len(p) == 0 || p[0] != '[' {
+		err = ret.UnmarshalJSON(p)
+		return ret, err
+	}
+	in := jlexer.Lexer{Data: p}
+	in.Delim('[')
+	for !in.IsDelim(']') {
+		var post Post
+		post.UnmarshalEasyJSON(&in)
+		ret.Items = append(ret.Items, post)
+		in.WantComma()
+	}
+	in.Delim(']')
+	in.Consumed()
+	ret.Count = len(ret.Items)
+	return ret, in.Error()
+}
+
+// Search returns posts of the wall selected by q which match query.
+func (s *WallService) Search(ctx context.Context, q WallQuery, query string) (Posts, error) {
+	options := q.wall()
+	if q.Filter == WallFilterOwner {
+		options = append(options, WithNumber("owners_only", 1))
+	}
+	return s.collect(ctx, "wall.search", append(options,
+		WithParam("query", query),
+	)...)
+}
+
+// Post publishes p and returns id of the created post.
+func (s *WallService) Post(ctx context.Context, p WallPost) (int, error) {
+	options := append(p.options(), withFlag("from_group", p.FromGroup))
+	if p.OwnerID != 0 {
+		options = append(options, WithNumber("owner_id", p.OwnerID))
+	}
+	return s.postID(ctx, "wall.post", options...)
+}
+
+// Edit replaces the post referred by ref with p.
+func (s *WallService) Edit(ctx context.Context, ref PostRef, p WallPost) error {
+	_, err := s.postID(ctx, "wall.edit", append(p.options(), ref.options()...)...)
+	return err
+}
+
+// Delete deletes the post referred by ref.
+func (s *WallService) Delete(ctx context.Context, ref PostRef) error {
+	_, err := s.caller("wall.delete").Call(ctx, ref.options()...)
+	return err
+}
+
+// Restore restores the post referred by ref after deletion.
+func (s *WallService) Restore(ctx context.Context, ref PostRef) error {
+	_, err := s.caller("wall.restore").Call(ctx, ref.options()...)
+	return err
+}
+
+// Pin pins the post referred by ref to the top of the wall.
+func (s *WallService) Pin(ctx context.Context, ref PostRef) error {
+	_, err := s.caller("wall.pin").Call(ctx, ref.options()...)
+	return err
+}
+
+// Unpin unpins the post referred by ref.
+func (s *WallService) Unpin(ctx context.Context, ref PostRef) error {
+	_, err := s.caller("wall.unpin").Call(ctx, ref.options()...)
+	return err
+}
+
+// Repost shares the post referred by ref on the token owner wall or on the
+// wall of community with given id if it is not zero. It returns id of the
+// created post.
+func (s *WallService) Repost(ctx context.Context, ref PostRef, message string, groupID int) (int, error) {
+	options := QueryOptions(
+		WithParam("object", "wall"+ref.String()),
+	)
+	if message != "" {
+		options = append(options, WithParam("message", message))
+	}
+	if groupID != 0 {
+		options = append(options, WithNumber("group_id", groupID))
+	}
+	return s.postID(ctx, "wall.repost", options...)
+}
+
+func (s *WallService) postID(ctx context.Context, method string, options ...QueryOption) (int, error) {
+	bts, err := s.caller(method).Call(ctx, options...)
+	if err != nil {
+		return 0, err
+	}
+	var ret rawPostID
+	if err := ret.UnmarshalJSON(bts); err != nil {
+		return 0, err
+	}
+	return ret.PostID, nil
+}
+
+func (s *WallService) collect(ctx context.Context, method string, options ...QueryOption) (ret Posts, err error) {
+	it := s.iterator(method, options...)
+	it.Parse = func(p []byte) (int, error) {
+		var page Posts
+		if err := page.UnmarshalJSON(p); err != nil {
+			return 0, err
+		}
+		ret.merge(page)
+		ret.Count = page.Count
+		return len(page.Items), nil
+	}
+	for it.Next(ctx) {
+	}
+	return ret, it.Err()
+}
+
+// merge appends items of page to p. Profiles and groups are deduplicated.
+func (p *Posts) merge(page Posts) {
+	p.Items = append(p.Items, page.Items...)
+outerUsers:
+	for _, u := range page.Profiles {
+		for _, x := range p.Profiles {
+			if x.ID == u.ID {
+				continue outerUsers
+			}
+		}
+		p.Profiles = append(p.Profiles, u)
+	}
+outerGroups:
+	for _, g := range page.Groups {
+		for _, x := range p.Groups {
+			if x.ID == g.ID {
+				continue outerGroups
+			}
+		}
+		p.Groups = append(p.Groups, g)
+	}
+}
+
+func (s *WallService) iterator(method string, options ...QueryOption) *Iterator {
+	return &Iterator{
+		Client: s.Client,
+		Method: method,
+		Options: append(s.options(), append(options,
+			WithNumber("count", MaxPostsPerRequest),
+		)...),
+		Limiter: s.Limiter,
+		Retry:   s.Retry,
+	}
+}
+
+func (s *WallService) caller(method string) *Caller {
+	return &Caller{
+		Client:  s.Client,
+		Method:  method,
+		Options: s.options(),
+		Limiter: s.Limiter,
+		Retry:   s.Retry,
+	}
+}
+
+func (s *WallService) options() []QueryOption {
+	if s.Token == nil {
+		return nil
+	}
+	return QueryOptions(WithAccessToken(s.Token))
+}
+
+// withExtended sets extended and fields parameters if extended is true.
+func withExtended(extended bool, fields []UserField) QueryOption {
+	if !extended {
+		return WithOptions(nil)
+	}
+	return WithOptions(QueryOptions(
+		WithNumber("extended", 1),
+		WithUserFields(fields),
+	))
+}
+
+// withFlag sets key to 1 if v is true. Otherwise the key is not sent.
+func withFlag(key string, v bool) QueryOption {
+	if v {
+		return WithNumber(key, 1)
+	}
+	return WithOptions(nil)
+}
@@ -0,0 +1,182 @@
+package vk_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/gobwas/vk"
+	"github.com/gobwas/vk/vktest"
+)
+
+func wallService(srv *vktest.Server, user int) *vk.WallService {
+	return &vk.WallService{
+		Client:  srv.Client(),
+		Token:   srv.Token(user),
+		Limiter: rate.NewLimiter(rate.Inf, 1),
+	}
+}
+
+func wallState(n int) *vktest.State {
+	state := &vktest.State{
+		Users: []vk.User{
+			{ID: 1, ScreenName: "alice"},
+			{ID: 2, ScreenName: "bob"},
+		},
+	}
+	for i := 0; i < n; i++ {
+		// Odd posts are written by the owner, even by the other user.
+		state.Posts = append(state.Posts, vk.Post{
+			ID:      n - i,
+			OwnerID: 1,
+			FromID:  2 - (n-i)%2,
+			Text:    "hello",
+			Date:    vk.UnixTime{Time: time.Unix(int64(1e6-i), 0)},
+		})
+	}
+	return state
+}
+
+func TestWallGet(t *testing.T) {
+	srv := vktest.NewServer(wallState(250))
+	defer srv.Close()
+	s := wallService(srv, 1)
+
+	for _, test := range []struct {
+		name     string
+		query    vk.WallQuery
+		count    int
+		profiles int
+	}{
+		{
+			name:  "all",
+			query: vk.WallQuery{},
+			count: 250,
+		},
+		{
+			name: "others by domain",
+			query: vk.WallQuery{
+				Domain: "alice",
+				Filter: vk.WallFilterOthers,
+			},
+			count: 125,
+		},
+		{
+			name: "extended",
+			query: vk.WallQuery{
+				OwnerID:  1,
+				Extended: true,
+			},
+			count:    250,
+			profiles: 2,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			posts, err := s.Get(context.Background(), test.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if posts.Count != test.count || len(posts.Items) != test.count {
+				t.Errorf(
+					"got %d posts of %d; want %d",
+					len(posts.Items), posts.Count, test.count,
+				)
+			}
+			if n := len(posts.Profiles); n != test.profiles {
+				t.Errorf("got %d profiles; want %d", n, test.profiles)
+			}
+		})
+	}
+}
+
+func TestWallLifecycle(t *testing.T) {
+	srv := vktest.NewServer(wallState(3))
+	defer srv.Close()
+	s := wallService(srv, 1)
+	ctx := context.Background()
+
+	id, err := s.Post(ctx, vk.WallPost{
+		Message:     "new post",
+		FriendsOnly: true,
+		Attachments: []string{
+			vk.AttachmentID(vk.AttachmentPhoto, 1, 42),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ref := vk.PostRef{OwnerID: 1, ID: id}
+
+	get := func() vk.Post {
+		t.Helper()
+		posts, err := s.GetByID(ctx, []vk.PostRef{ref}, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(posts.Items) != 1 {
+			t.Fatalf("got %d posts; want 1", len(posts.Items))
+		}
+		return posts.Items[0]
+	}
+	post := get()
+	if post.Text != "new post" || len(post.Attachments) != 1 || post.Attachments[0].Photo.ID != 42 {
+		t.Errorf("unexpected post: %+v", post)
+	}
+
+	if err := s.Edit(ctx, ref, vk.WallPost{Message: "edited"}); err != nil {
+		t.Fatal(err)
+	}
+	if post := get(); post.Text != "edited" {
+		t.Errorf("unexpected text of edited post: %q", post.Text)
+	} else if !post.FriendsOnly {
+		t.Errorf("edit cleared friends_only flag")
+	}
+	found, err := s.Search(ctx, vk.WallQuery{Filter: vk.WallFilterOthers}, "edit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found.Items) != 1 || found.Items[0].ID != id {
+		t.Errorf("unexpected search result: %+v", found.Items)
+	}
+
+	if err := s.Pin(ctx, ref); err != nil {
+		t.Fatal(err)
+	}
+	if post := get(); !post.IsPinned {
+		t.Errorf("post is not pinned")
+	}
+	if err := s.Unpin(ctx, ref); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.Delete(ctx, ref); err != nil {
+		t.Fatal(err)
+	}
+	if posts, _ := s.GetByID(ctx, []vk.PostRef{ref}, false); len(posts.Items) != 0 {
+		t.Errorf("post is not deleted")
+	}
+	if err := s.Restore(ctx, ref); err != nil {
+		t.Fatal(err)
+	}
+	get()
+	if err := s.Restore(ctx, ref); !errors.Is(err, vk.ErrNotFound) {
+		t.Errorf("unexpected error of second restore: %v", err)
+	}
+
+	other := wallService(srv, 2)
+	repost, err := other.Repost(ctx, ref, "look", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	posts, err := other.GetByID(ctx, []vk.PostRef{{OwnerID: 2, ID: repost}}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(posts.Items) != 1 || len(posts.Items[0].CopyHistory) != 1 ||
+		posts.Items[0].CopyHistory[0].ID != id {
+		t.Errorf("unexpected repost: %+v", posts.Items)
+	}
+}